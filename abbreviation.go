@@ -0,0 +1,207 @@
+package slugcraft
+
+import (
+	"sort"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
+
+// Abbreviation is a replacement rule applied to the input before
+// transliteration.
+type Abbreviation struct {
+	From     string // Text to look for
+	To       string // Replacement text
+	Priority int    // Rules with higher priority win over overlapping ones
+}
+
+// acNode is a state of the Aho-Corasick automaton.
+type acNode struct {
+	next map[rune]int
+	fail int
+	out  []int // indices of the rules that end in this state
+}
+
+// abbrMatcher finds every abbreviation in a single pass over the input and
+// resolves overlaps deterministically: higher Priority first, then the longer
+// match, then the leftmost one, then the rule added first.
+type abbrMatcher struct {
+	rules        []Abbreviation
+	lengths      []int // rune length of each rule's From
+	nodes        []acNode
+	wordBoundary bool
+	ignoreCase   bool
+}
+
+// abbrMatch is one occurrence of a rule in the input, in rune offsets.
+type abbrMatch struct {
+	start, end, rule int
+}
+
+// newAbbrMatcher builds the automaton for rules. Rules with an empty From are
+// ignored.
+func newAbbrMatcher(rules []Abbreviation, wordBoundary, ignoreCase bool) *abbrMatcher {
+	m := &abbrMatcher{
+		rules:        rules,
+		lengths:      make([]int, len(rules)),
+		nodes:        []acNode{{next: map[rune]int{}}},
+		wordBoundary: wordBoundary,
+		ignoreCase:   ignoreCase,
+	}
+
+	// Build the trie
+	for i, rule := range rules {
+		m.lengths[i] = utf8.RuneCountInString(rule.From)
+		if rule.From == "" {
+			continue
+		}
+		state := 0
+		for _, r := range rule.From {
+			r = m.fold(r)
+			child, ok := m.nodes[state].next[r]
+			if !ok {
+				child = len(m.nodes)
+				m.nodes = append(m.nodes, acNode{next: map[rune]int{}})
+				m.nodes[state].next[r] = child
+			}
+			state = child
+		}
+		m.nodes[state].out = append(m.nodes[state].out, i)
+	}
+
+	// Compute failure links breadth-first, in sorted rune order so the
+	// automaton is identical on every run
+	queue := make([]int, 0, len(m.nodes))
+	for _, r := range sortedKeys(m.nodes[0].next) {
+		queue = append(queue, m.nodes[0].next[r])
+	}
+	for len(queue) > 0 {
+		state := queue[0]
+		queue = queue[1:]
+		for _, r := range sortedKeys(m.nodes[state].next) {
+			child := m.nodes[state].next[r]
+			fail := m.nodes[state].fail
+			for {
+				if target, ok := m.nodes[fail].next[r]; ok && target != child {
+					m.nodes[child].fail = target
+					break
+				}
+				if fail == 0 {
+					m.nodes[child].fail = 0
+					break
+				}
+				fail = m.nodes[fail].fail
+			}
+			m.nodes[child].out = append(m.nodes[child].out, m.nodes[m.nodes[child].fail].out...)
+			queue = append(queue, child)
+		}
+	}
+	return m
+}
+
+// fold normalizes r for matching.
+func (m *abbrMatcher) fold(r rune) rune {
+	if m.ignoreCase {
+		return unicode.ToLower(r)
+	}
+	return r
+}
+
+// replace applies the rules to s.
+func (m *abbrMatcher) replace(s string) string {
+	runes := []rune(s)
+
+	// Collect every occurrence in one pass
+	var matches []abbrMatch
+	state := 0
+	for i, r := range runes {
+		r = m.fold(r)
+		for {
+			if next, ok := m.nodes[state].next[r]; ok {
+				state = next
+				break
+			}
+			if state == 0 {
+				break
+			}
+			state = m.nodes[state].fail
+		}
+		for _, rule := range m.nodes[state].out {
+			start := i + 1 - m.lengths[rule]
+			if m.wordBoundary && !atWordBoundary(runes, start, i+1) {
+				continue
+			}
+			matches = append(matches, abbrMatch{start: start, end: i + 1, rule: rule})
+		}
+	}
+	if len(matches) == 0 {
+		return s
+	}
+
+	// Resolve overlaps
+	sort.Slice(matches, func(i, j int) bool {
+		a, b := matches[i], matches[j]
+		if pa, pb := m.rules[a.rule].Priority, m.rules[b.rule].Priority; pa != pb {
+			return pa > pb
+		}
+		if la, lb := a.end-a.start, b.end-b.start; la != lb {
+			return la > lb
+		}
+		if a.start != b.start {
+			return a.start < b.start
+		}
+		return a.rule < b.rule
+	})
+	taken := make([]bool, len(runes))
+	accepted := matches[:0]
+	for _, match := range matches {
+		free := true
+		for i := match.start; i < match.end; i++ {
+			if taken[i] {
+				free = false
+				break
+			}
+		}
+		if !free {
+			continue
+		}
+		for i := match.start; i < match.end; i++ {
+			taken[i] = true
+		}
+		accepted = append(accepted, match)
+	}
+	sort.Slice(accepted, func(i, j int) bool { return accepted[i].start < accepted[j].start })
+
+	var b strings.Builder
+	b.Grow(len(s))
+	last := 0
+	for _, match := range accepted {
+		b.WriteString(string(runes[last:match.start]))
+		b.WriteString(m.rules[match.rule].To)
+		last = match.end
+	}
+	b.WriteString(string(runes[last:]))
+	return b.String()
+}
+
+// atWordBoundary reports whether runes[start:end] is not glued to a letter or
+// digit on either side.
+func atWordBoundary(runes []rune, start, end int) bool {
+	if start > 0 && !isSeparator(runes[start-1]) {
+		return false
+	}
+	if end < len(runes) && !isSeparator(runes[end]) {
+		return false
+	}
+	return true
+}
+
+// sortedKeys returns the keys of m in ascending order.
+func sortedKeys(m map[rune]int) []rune {
+	keys := make([]rune, 0, len(m))
+	for r := range m {
+		keys = append(keys, r)
+	}
+	sort.Slice(keys, func(i, j int) bool { return keys[i] < keys[j] })
+	return keys
+}
@@ -1,5 +1,12 @@
 package slugcraft
 
+import "context"
+
+// NewCache creates an empty in-memory Cache.
+func NewCache() *Cache {
+	return &Cache{Store: make(map[string]int, 1000)}
+}
+
 // Set adds a slug to the in-memory cache.
 func (c *Cache) Set(slug string) {
 	c.Mu.Lock()
@@ -21,3 +28,34 @@ func (c *Cache) Del(slug string) {
 	defer c.Mu.Unlock()
 	delete(c.Store, slug)
 }
+
+// Reserve claims slug if no one has taken it yet.
+func (c *Cache) Reserve(ctx context.Context, slug string) (bool, error) {
+	if err := ctx.Err(); err != nil {
+		return false, err
+	}
+	c.Mu.Lock()
+	defer c.Mu.Unlock()
+	if _, exist := c.Store[slug]; exist {
+		return false, nil
+	}
+	c.Store[slug] = 0
+	return true, nil
+}
+
+// Exists reports whether slug has been reserved.
+func (c *Cache) Exists(ctx context.Context, slug string) (bool, error) {
+	if err := ctx.Err(); err != nil {
+		return false, err
+	}
+	return c.Get(slug), nil
+}
+
+// Release frees slug so it can be handed out again.
+func (c *Cache) Release(ctx context.Context, slug string) error {
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+	c.Del(slug)
+	return nil
+}
@@ -9,6 +9,7 @@ import (
 	"sync"
 
 	slugcraft "github.com/mnuddindev/slugcraft"
+	"github.com/mnuddindev/slugcraft/filestore"
 )
 
 var Version = "v1.2.0"
@@ -16,10 +17,15 @@ var Version = "v1.2.0"
 func main() {
 	// Define flags
 	input := flag.String("input", "", "Text to slugify")
-	lang := flag.String("lang", "", "Language (bn, default: en)")
+	lang := flag.String("lang", "", "Language (auto, bn, ru, el, ar, he, yi, hi, mr, ne, zh, ja, ko, th, lo, km, my, uk, be, sr, bg, kk, de, da, no, sv, fr, es, tr, pl, vi and other Latin-script codes, default: en)")
+	scheme := flag.String("scheme", "", "Romanization scheme, optionally per language (e.g., gost, ru:iso9, bn:iast)")
 	cache := flag.Bool("cache", false, "Enable in-memory cache for uniqueness")
-	suffix := flag.String("suffix", "numeric", "Suffix style: numeric, version, revision")
+	store := flag.String("store", "", "File that keeps slugs unique across runs (implies -cache)")
+	suffix := flag.String("suffix", "numeric", "Suffix style: numeric, version, revision, timestamp, unix, shortid, shortid62, hash, uuid")
+	suffixLen := flag.Int("suffixlen", 0, "Length of shortid and hash suffixes (0: style default)")
+	suffixSep := flag.String("suffixsep", "-", "Separator between slug and suffix")
 	maxLength := flag.Int("max", 100, "Maximum slug length")
+	truncate := flag.String("truncate", "hard", "Truncation mode: hard, word")
 	stopwords := flag.String("stopwords", "", "Language for stopwords (e.g., en)")
 	regex := flag.String("regex", "", "Regex pattern to filter (e.g., [^a-z0-9-])")
 	regexReplace := flag.String("replace", "", "Replacement for regex filter")
@@ -41,47 +47,62 @@ func main() {
 		os.Exit(0)
 	}
 
-	// Parse abbreviations
-	abbreviations := make(map[string]string)
-	if *abbr != "" {
-		pairs := strings.Split(*abbr, ",")
-		for _, pair := range pairs {
-			kv := strings.SplitN(pair, "=", 2)
-			if len(kv) == 2 {
-				abbreviations[kv[0]] = kv[1]
-			}
-		}
-	}
-
 	// Create Slugger with options
 	opts := []slugcraft.Options{
 		slugcraft.WithZeroAlloc(*zeroalloc),
 	}
 
 	if *lang != "" {
-		if *lang == "bn" || *lang == "en" {
+		switch *lang {
+		case "auto", "bn", "en", "ru", "el", "ar", "he", "yi", "hi", "mr", "ne", "zh", "ja", "ko", "th", "lo", "km", "my", "uk", "be", "sr", "bg", "kk",
+			"de", "da", "no", "nb", "nn", "sv", "fi", "is", "et", "fr", "es", "pt", "it", "ca", "nl",
+			"tr", "az", "pl", "cs", "sk", "sl", "hr", "bs", "hu", "ro", "lv", "lt", "mt", "vi":
 			opts = append(opts, slugcraft.WithLanguage(*lang))
-		} else {
+		default:
 			fmt.Println("Star the repository and wait for more language support. \n https://github.com/mnuddindev/slugcraft")
 		}
 	}
+	if *scheme != "" {
+		opts = append(opts, slugcraft.WithScheme(*scheme))
+	}
 	if *cache {
 		opts = append(opts, slugcraft.WithUseCache(true))
 	}
+	if *store != "" {
+		fs, err := filestore.Open(*store)
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "Error opening store: %v\n", err)
+			os.Exit(1)
+		}
+		opts = append(opts, slugcraft.WithUseCache(true), slugcraft.WithStore(fs))
+	}
 	if *suffix != "" {
 		opts = append(opts, slugcraft.WithSuffixStyle(*suffix))
 	}
+	if *suffixLen > 0 {
+		opts = append(opts, slugcraft.WithSuffixLength(*suffixLen))
+	}
+	opts = append(opts, slugcraft.WithSuffixSeparator(*suffixSep))
 	if *maxLength > 0 {
 		opts = append(opts, slugcraft.WithMaxLength(*maxLength))
 	}
+	if *truncate != "" {
+		opts = append(opts, slugcraft.WithTruncation(*truncate))
+	}
 	if *stopwords != "" {
 		opts = append(opts, slugcraft.WithStopWords(*stopwords))
 	}
 	if *regex != "" {
 		opts = append(opts, slugcraft.WithRegexFilter(*regex, *regexReplace))
 	}
-	for k, v := range abbreviations {
-		opts = append(opts, slugcraft.WithAbbreviation(k, v))
+	if *abbr != "" {
+		pairs := strings.Split(*abbr, ",")
+		for _, pair := range pairs {
+			kv := strings.SplitN(pair, "=", 2)
+			if len(kv) == 2 {
+				opts = append(opts, slugcraft.WithAbbreviation(kv[0], kv[1]))
+			}
+		}
 	}
 	if *file != "" {
 		data, err := os.ReadFile(*file)
@@ -138,7 +159,10 @@ func printUsage() {
 	})
 	fmt.Println("Examples:")
 	fmt.Println(`  slugcraft -input "বাংলা প্রিয়" -lang=bn`)
+	fmt.Println(`  slugcraft -input "Щука" -lang=ru -scheme=gost`)
+	fmt.Println(`  slugcraft -input "বাংলা News Москва" -lang=auto`)
 	fmt.Println(`  slugcraft -input "Hello the World" -stopwords=en -regex="[^a-z0-9-]" -replace=""`)
 	fmt.Println(`  slugcraft -input "বাংলা আমি" -lang=bn -abbr="বাংলা=BN,আমি=ME"`)
 	fmt.Println(`  slugcraft -input "café au lait" -zeroalloc=true`)
+	fmt.Println(`  slugcraft -input "Hello World" -store=slugs.log`)
 }
@@ -1,29 +1,57 @@
 package slugcraft
 
 import (
+	"context"
 	"regexp"
 	"strings"
 	"sync"
+	"sync/atomic"
 )
 
 // Config is the main struct for generating slugs.
+//
+// A Config is safe for concurrent use by multiple goroutines once New has
+// returned. Its fields must not be modified after construction.
 type Config struct {
-	MaxLength     int                 // Maximum allowed length of the final slug (e.g., 220 characters)
-	SuffixStyle   string              // Style of suffix: "numeric" (-2), "version" (-v2), "revision" (-rev2)
-	Language      string              // Language will hold the preferred Language to transliteration Default: english
-	RegexReplace  string              // Will hold the things that will be replaced
-	StopWords     map[string]struct{} // All words that will be removed from the input if given
-	Abbreviations map[string]string   // Abbreviations that will be removed from the input if given
-	UseCache      bool                // Flag to enable in-memory caching of slug lookups
-	ZeroAlloc     bool                // Controls zero-allocation mode
-	UseUnidecode  bool                // Optional unidecode fallback
-	Cache         *Cache              // In-memory cache struct
-	RegexFilter   *regexp.Regexp      // Regex pattern to replace certain characters from input if given
-	PipeLine      []Transformer       // Pipeline for step by step process
-	Builder       strings.Builder     // Buffer for zero-allocation processing
-}
-
-// Cache is a simple in-memory store for slug uniqueness.
+	MaxLength         int                 // Maximum allowed length of the final slug (e.g., 220 characters)
+	Truncation        string              // How slugs over MaxLength are cut: "hard" (default) or "word"
+	TruncateStopWords map[string]struct{} // Stopwords dropped first when truncating in "word" mode
+	SuffixStyle       string              // Style of suffix: "numeric" (-2), "version" (-v2), "revision" (-rev2), "timestamp", "unix", "shortid", "shortid62", "hash", "uuid"
+	SuffixLength      int                 // Length of "shortid" and "hash" suffixes (0 uses the style default)
+	SuffixSeparator   string              // Text placed between the slug and its suffix (default "-")
+	SuffixFunc        SuffixFunc          // Custom suffix generator, overrides SuffixStyle when set
+	Language          string              // Language will hold the preferred Language to transliteration Default: english
+	Schemes           map[string]string   // Romanization scheme per language, see WithScheme
+	KanjiReader       KanjiReader         // Supplies kanji readings for Japanese
+	RegexReplace      string              // Will hold the things that will be replaced
+	StopWords         map[string]struct{} // All words that will be removed from the input if given
+	Abbreviations     []Abbreviation      // Abbreviation rules replaced in the input, see WithAbbreviation
+	AbbrWordBoundary  bool                // Only replace abbreviations that stand as whole words
+	AbbrIgnoreCase    bool                // Match abbreviations case-insensitively
+	UseCache          bool                // Flag to enable uniqueness checks against Store
+	ZeroAlloc         bool                // Controls zero-allocation mode
+	UseUnidecode      bool                // Optional unidecode fallback
+	Store             UniquenessStore     // Backend that records slugs already handed out
+	RegexFilter       *regexp.Regexp      // Regex pattern to replace certain characters from input if given
+	PipeLine          []Transformer       // Pipeline for step by step process
+	abbr              *abbrMatcher        // Matcher built from Abbreviations by New
+	suffixHints       sync.Map            // Last counting suffix handed out, per base
+	suffixHintCount   atomic.Int64        // Number of entries in suffixHints
+}
+
+// UniquenessStore records which slugs have been handed out. Implementations
+// must be safe for concurrent use.
+type UniquenessStore interface {
+	// Reserve atomically claims slug and reports whether it was still free.
+	Reserve(ctx context.Context, slug string) (bool, error)
+	// Exists reports whether slug has already been reserved.
+	Exists(ctx context.Context, slug string) (bool, error)
+	// Release frees a previously reserved slug.
+	Release(ctx context.Context, slug string) error
+}
+
+// Cache is a simple in-memory store for slug uniqueness. It is the default
+// UniquenessStore.
 type Cache struct {
 	Mu    sync.RWMutex
 	Store map[string]int
@@ -32,6 +60,11 @@ type Cache struct {
 // Option defines a functional option for configuring Config.
 type Options func(*Config)
 
+// SuffixFunc returns the suffix appended verbatim to base on the given
+// collision attempt, starting at 1. It is called with increasing attempts
+// until the resulting slug is free.
+type SuffixFunc func(base string, attempt int) string
+
 // Transformer defines a function that transform a string in pipeline.
 type Transformer func(*strings.Builder)
 
@@ -43,12 +76,13 @@ func New(options ...Options) *Config {
 			ReplaceSpaces("-"),
 			TrimDashes(),
 		},
-		Language:    "",
-		MaxLength:   220,
-		UseCache:    false,
-		ZeroAlloc:   true,
-		SuffixStyle: "numeric",
-		Cache:       &Cache{Store: make(map[string]int, 1000)},
+		Language:        "",
+		MaxLength:       220,
+		UseCache:        false,
+		ZeroAlloc:       true,
+		SuffixStyle:     "numeric",
+		SuffixSeparator: "-",
+		Store:           NewCache(),
 	}
 	for _, opt := range options {
 		opt(cfg)
@@ -56,6 +90,9 @@ func New(options ...Options) *Config {
 	if cfg.MaxLength <= 0 {
 		cfg.MaxLength = 220
 	}
+	if len(cfg.Abbreviations) > 0 {
+		cfg.abbr = newAbbrMatcher(cfg.Abbreviations, cfg.AbbrWordBoundary, cfg.AbbrIgnoreCase)
+	}
 	return cfg
 }
 
@@ -73,13 +110,51 @@ func WithZeroAlloc(enabled bool) Options {
 	}
 }
 
-// WithLanguage sets the language for transliteration.
+// WithLanguage sets the language for transliteration. "auto" picks the
+// language of each script run in mixed-script input.
 func WithLanguage(lang string) Options {
 	return func(cfg *Config) {
 		cfg.Language = lang
 	}
 }
 
+// WithScheme selects a named romanization scheme. Qualify the name with a
+// language ("ru:gost", "bn:iast") or give it bare ("gost") to apply it to
+// whichever language has a scheme of that name. Unknown schemes fall back to
+// the language default.
+func WithScheme(name string) Options {
+	return func(cfg *Config) {
+		lang, scheme, ok := strings.Cut(name, ":")
+		if !ok {
+			lang, scheme = "", name
+		}
+		if cfg.Schemes == nil {
+			cfg.Schemes = make(map[string]string)
+		}
+		cfg.Schemes[lang] = scheme
+	}
+}
+
+// WithArabicScheme sets the romanization scheme used for Arabic ("simple",
+// "arabizi"). It is shorthand for WithScheme("ar:" + scheme).
+func WithArabicScheme(scheme string) Options {
+	return WithScheme("ar:" + scheme)
+}
+
+// WithRussianScheme sets the romanization scheme used for Russian ("simple",
+// "translit", "gost", "iso9", "bgn"). It is shorthand for
+// WithScheme("ru:" + scheme).
+func WithRussianScheme(scheme string) Options {
+	return WithScheme("ru:" + scheme)
+}
+
+// WithKanjiReader sets the dictionary used to read kanji in Japanese text.
+func WithKanjiReader(reader KanjiReader) Options {
+	return func(cfg *Config) {
+		cfg.KanjiReader = reader
+	}
+}
+
 // WithUseCache enables or disables in-memory caching for uniqueness
 func WithUseCache(use bool) Options {
 	return func(cfg *Config) {
@@ -87,6 +162,15 @@ func WithUseCache(use bool) Options {
 	}
 }
 
+// WithStore sets the backend used for uniqueness checks.
+func WithStore(store UniquenessStore) Options {
+	return func(cfg *Config) {
+		if store != nil {
+			cfg.Store = store
+		}
+	}
+}
+
 // WithUnidecode enables the unidecode fallback.
 func WithUnidecode(enabled bool) Options {
 	return func(cfg *Config) {
@@ -94,18 +178,43 @@ func WithUnidecode(enabled bool) Options {
 	}
 }
 
-// WithSuffixStyle sets the style for suffix generation ("numeric", "version", "revision")
+// WithSuffixStyle sets the style for suffix generation ("numeric", "version",
+// "revision", "timestamp", "unix", "shortid", "shortid62", "hash", "uuid").
+// Unknown styles fall back to "numeric".
 func WithSuffixStyle(style string) Options {
 	return func(cfg *Config) {
-		switch style {
-		case "numeric", "version", "revision":
+		if validSuffixStyle(style) {
 			cfg.SuffixStyle = style
-		default:
+		} else {
 			cfg.SuffixStyle = "numeric"
 		}
 	}
 }
 
+// WithSuffixLength sets the length of "shortid" and "hash" suffixes
+func WithSuffixLength(length int) Options {
+	return func(cfg *Config) {
+		if length > 0 {
+			cfg.SuffixLength = length
+		}
+	}
+}
+
+// WithSuffixSeparator sets the text placed between the slug and its suffix
+func WithSuffixSeparator(sep string) Options {
+	return func(cfg *Config) {
+		cfg.SuffixSeparator = sep
+	}
+}
+
+// WithSuffixFunc sets a custom suffix generator, e.g. one producing "-2024"
+// or "-part-2". It takes precedence over the suffix style.
+func WithSuffixFunc(fn SuffixFunc) Options {
+	return func(cfg *Config) {
+		cfg.SuffixFunc = fn
+	}
+}
+
 // WithMaxLength sets the maximum length of the slug
 func WithMaxLength(max int) Options {
 	return func(cfg *Config) {
@@ -117,6 +226,27 @@ func WithMaxLength(max int) Options {
 	}
 }
 
+// WithTruncation sets how slugs longer than MaxLength are cut: "hard" cuts at
+// the rune limit, "word" cuts at the last separator before it.
+func WithTruncation(mode string) Options {
+	return func(cfg *Config) {
+		switch mode {
+		case "hard", "word":
+			cfg.Truncation = mode
+		default:
+			cfg.Truncation = "hard"
+		}
+	}
+}
+
+// WithTruncateStopWords makes "word" truncation drop stopwords of the given
+// language, starting from the end, before cutting any other word.
+func WithTruncateStopWords(lang string) Options {
+	return func(cfg *Config) {
+		cfg.TruncateStopWords = DefaultStopWords(lang)
+	}
+}
+
 // WithRegexFilter sets a regex pattern to filter characters
 func WithRegexFilter(pattern, replace string) Options {
 	return func(cfg *Config) {
@@ -132,13 +262,39 @@ func WithStopWords(lang string) Options {
 	}
 }
 
-// WithAbbreviation adds a custom abbreviation rule.
+// WithAbbreviation adds a custom abbreviation rule. Adding a rule for the same
+// text again replaces the earlier one. When rules overlap, the longest match
+// wins unless priorities say otherwise (see WithAbbreviationPriority).
 func WithAbbreviation(from, to string) Options {
+	return WithAbbreviationPriority(from, to, 0)
+}
+
+// WithAbbreviationPriority adds an abbreviation rule that beats overlapping
+// rules with a lower priority, regardless of match length.
+func WithAbbreviationPriority(from, to string, priority int) Options {
 	return func(cfg *Config) {
-		if cfg.Abbreviations == nil {
-			cfg.Abbreviations = make(map[string]string)
+		rule := Abbreviation{From: from, To: to, Priority: priority}
+		for i := range cfg.Abbreviations {
+			if cfg.Abbreviations[i].From == from {
+				cfg.Abbreviations[i] = rule
+				return
+			}
 		}
-		cfg.Abbreviations[from] = to
+		cfg.Abbreviations = append(cfg.Abbreviations, rule)
+	}
+}
+
+// WithAbbreviationWordBoundary restricts abbreviations to whole words.
+func WithAbbreviationWordBoundary(enabled bool) Options {
+	return func(cfg *Config) {
+		cfg.AbbrWordBoundary = enabled
+	}
+}
+
+// WithAbbreviationIgnoreCase matches abbreviations case-insensitively.
+func WithAbbreviationIgnoreCase(enabled bool) Options {
+	return func(cfg *Config) {
+		cfg.AbbrIgnoreCase = enabled
 	}
 }
 
@@ -0,0 +1,282 @@
+// Package filestore provides a slugcraft.UniquenessStore persisted in an
+// append-only file, so that slugs stay unique across separate runs of a
+// program such as the slugcraft CLI.
+//
+// Every reservation appends a "+slug" line and every release a "-slug" line.
+// Access to the file is serialized with an advisory lock, which lets several
+// processes share one store. Once enough released entries pile up the log is
+// rewritten to contain only the live slugs.
+package filestore
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+	"sync"
+
+	slugcraft "github.com/mnuddindev/slugcraft"
+)
+
+// Store keeps the set of reserved slugs in memory and mirrors every change to
+// the log file.
+type Store struct {
+	mu        sync.Mutex
+	path      string
+	slugs     map[string]struct{}
+	info      os.FileInfo // identity of the log file the state was read from
+	offset    int64       // bytes of the log already applied to slugs
+	dead      int         // records in the log that no longer describe a live slug
+	compactAt int
+}
+
+var _ slugcraft.UniquenessStore = (*Store)(nil)
+
+// Option defines a functional option for configuring Store.
+type Option func(*Store)
+
+// WithCompactThreshold sets how many dead records the log may hold before it
+// is compacted automatically (default: 1000). Zero disables auto compaction.
+func WithCompactThreshold(n int) Option {
+	return func(s *Store) {
+		if n >= 0 {
+			s.compactAt = n
+		}
+	}
+}
+
+// Open opens the store at path, creating the file if it does not exist.
+func Open(path string, opts ...Option) (*Store, error) {
+	s := &Store{
+		path:      path,
+		slugs:     make(map[string]struct{}),
+		compactAt: 1000,
+	}
+	for _, opt := range opts {
+		opt(s)
+	}
+	err := s.locked(context.Background(), func(*os.File) error { return nil })
+	if err != nil {
+		return nil, err
+	}
+	return s, nil
+}
+
+// Reserve claims slug and records it in the log.
+func (s *Store) Reserve(ctx context.Context, slug string) (bool, error) {
+	if err := validSlug(slug); err != nil {
+		return false, err
+	}
+	reserved := false
+	err := s.locked(ctx, func(f *os.File) error {
+		if _, exist := s.slugs[slug]; exist {
+			return nil
+		}
+		if err := s.appendRecord(f, '+', slug); err != nil {
+			return err
+		}
+		s.slugs[slug] = struct{}{}
+		reserved = true
+		return nil
+	})
+	return reserved, err
+}
+
+// Exists reports whether slug is reserved by this or any other process.
+func (s *Store) Exists(ctx context.Context, slug string) (bool, error) {
+	exists := false
+	err := s.locked(ctx, func(*os.File) error {
+		_, exists = s.slugs[slug]
+		return nil
+	})
+	return exists, err
+}
+
+// Release frees slug and records the removal in the log.
+func (s *Store) Release(ctx context.Context, slug string) error {
+	if err := validSlug(slug); err != nil {
+		return err
+	}
+	return s.locked(ctx, func(f *os.File) error {
+		if _, exist := s.slugs[slug]; !exist {
+			return nil
+		}
+		if err := s.appendRecord(f, '-', slug); err != nil {
+			return err
+		}
+		delete(s.slugs, slug)
+		// Both the reservation and the release are now dead records
+		s.dead += 2
+		if s.compactAt > 0 && s.dead >= s.compactAt {
+			return s.compact(f)
+		}
+		return nil
+	})
+}
+
+// Compact rewrites the log so it holds exactly one record per live slug.
+func (s *Store) Compact(ctx context.Context) error {
+	return s.locked(ctx, func(f *os.File) error {
+		return s.compact(f)
+	})
+}
+
+// Len returns the number of reserved slugs.
+func (s *Store) Len() int {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return len(s.slugs)
+}
+
+// locked takes the process and file locks, brings the in-memory state up to
+// date with the log and then calls fn with the log opened for appending.
+func (s *Store) locked(ctx context.Context, fn func(f *os.File) error) error {
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	unlock, err := lockFile(ctx, s.path+".lock")
+	if err != nil {
+		return fmt.Errorf("filestore: lock %s: %w", s.path, err)
+	}
+	defer unlock()
+
+	f, err := os.OpenFile(s.path, os.O_RDWR|os.O_CREATE|os.O_APPEND, 0o644)
+	if err != nil {
+		return fmt.Errorf("filestore: %w", err)
+	}
+	defer f.Close() // compact may already have closed it
+
+	if err := s.sync(f); err != nil {
+		return fmt.Errorf("filestore: read %s: %w", s.path, err)
+	}
+	return fn(f)
+}
+
+// sync applies the records other processes appended since the last call. If
+// the file was replaced by a compaction the whole log is read again.
+func (s *Store) sync(f *os.File) error {
+	info, err := f.Stat()
+	if err != nil {
+		return err
+	}
+	if s.info == nil || !os.SameFile(s.info, info) || info.Size() < s.offset {
+		s.slugs = make(map[string]struct{}, len(s.slugs))
+		s.offset = 0
+		s.dead = 0
+	}
+	s.info = info
+	if info.Size() == s.offset {
+		return nil
+	}
+
+	if _, err := f.Seek(s.offset, io.SeekStart); err != nil {
+		return err
+	}
+	r := bufio.NewReader(f)
+	for {
+		line, err := r.ReadBytes('\n')
+		if errors.Is(err, io.EOF) {
+			// A record without its newline is a write that never finished
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+		s.offset += int64(len(line))
+		s.apply(bytes.TrimSuffix(line, []byte{'\n'}))
+	}
+}
+
+// apply replays a single log record.
+func (s *Store) apply(record []byte) {
+	if len(record) < 2 {
+		return
+	}
+	slug := string(record[1:])
+	switch record[0] {
+	case '+':
+		if _, exist := s.slugs[slug]; exist {
+			s.dead++
+			return
+		}
+		s.slugs[slug] = struct{}{}
+	case '-':
+		if _, exist := s.slugs[slug]; exist {
+			delete(s.slugs, slug)
+			s.dead += 2
+			return
+		}
+		s.dead++
+	}
+}
+
+// appendRecord writes one record to the end of the log.
+func (s *Store) appendRecord(f *os.File, op byte, slug string) error {
+	record := make([]byte, 0, len(slug)+2)
+	record = append(record, op)
+	record = append(record, slug...)
+	record = append(record, '\n')
+	n, err := f.Write(record)
+	s.offset += int64(n)
+	if err != nil {
+		return fmt.Errorf("filestore: write %s: %w", s.path, err)
+	}
+	return nil
+}
+
+// compact writes the live slugs to a temporary file and swaps it in place of
+// the log. The caller must hold the locks. log, the open log file, is closed
+// first because Windows cannot replace a file that is still open.
+func (s *Store) compact(log *os.File) error {
+	tmp := s.path + ".tmp"
+	f, err := os.OpenFile(tmp, os.O_WRONLY|os.O_CREATE|os.O_TRUNC, 0o644)
+	if err != nil {
+		return fmt.Errorf("filestore: compact: %w", err)
+	}
+	w := bufio.NewWriter(f)
+	var size int64
+	for slug := range s.slugs {
+		n, _ := w.WriteString("+" + slug + "\n")
+		size += int64(n)
+	}
+	if err := w.Flush(); err != nil {
+		f.Close()
+		os.Remove(tmp)
+		return fmt.Errorf("filestore: compact: %w", err)
+	}
+	if err := f.Sync(); err != nil {
+		f.Close()
+		os.Remove(tmp)
+		return fmt.Errorf("filestore: compact: %w", err)
+	}
+	info, err := f.Stat()
+	f.Close()
+	if err != nil {
+		os.Remove(tmp)
+		return fmt.Errorf("filestore: compact: %w", err)
+	}
+	log.Close()
+	if err := os.Rename(tmp, s.path); err != nil {
+		os.Remove(tmp)
+		return fmt.Errorf("filestore: compact: %w", err)
+	}
+	s.info = info
+	s.offset = size
+	s.dead = 0
+	return nil
+}
+
+// validSlug rejects slugs that would break the line-based log format.
+func validSlug(slug string) error {
+	if slug == "" || strings.ContainsAny(slug, "\r\n") {
+		return fmt.Errorf("filestore: invalid slug %q", slug)
+	}
+	return nil
+}
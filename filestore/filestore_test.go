@@ -0,0 +1,170 @@
+package filestore
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"sync"
+	"testing"
+
+	slugcraft "github.com/mnuddindev/slugcraft"
+)
+
+// openStore opens a store at path and fails the test on error.
+func openStore(t *testing.T, path string, opts ...Option) *Store {
+	t.Helper()
+	s, err := Open(path, opts...)
+	if err != nil {
+		t.Fatalf("Open(%q) returned error: %v", path, err)
+	}
+	return s
+}
+
+// TestStoreOperations tests reserve, exists and release.
+func TestStoreOperations(t *testing.T) {
+	s := openStore(t, filepath.Join(t.TempDir(), "slugs.log"))
+	ctx := context.Background()
+
+	ok, err := s.Reserve(ctx, "hello-world")
+	if err != nil || !ok {
+		t.Fatalf("Reserve = %v, %v, expected true, nil", ok, err)
+	}
+	ok, err = s.Reserve(ctx, "hello-world")
+	if err != nil || ok {
+		t.Errorf("second Reserve = %v, %v, expected false, nil", ok, err)
+	}
+	if exists, _ := s.Exists(ctx, "hello-world"); !exists {
+		t.Errorf("Exists = false, expected true")
+	}
+	if err := s.Release(ctx, "hello-world"); err != nil {
+		t.Errorf("Release returned error: %v", err)
+	}
+	if exists, _ := s.Exists(ctx, "hello-world"); exists {
+		t.Errorf("Exists after release = true, expected false")
+	}
+	if _, err := s.Reserve(ctx, "bad\nslug"); err == nil {
+		t.Errorf("Reserve with newline did not return error")
+	}
+}
+
+// TestPersistence tests that reservations survive reopening the store.
+func TestPersistence(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "slugs.log")
+	ctx := context.Background()
+
+	first := slugcraft.New(slugcraft.WithUseCache(true), slugcraft.WithStore(openStore(t, path)))
+	if slug, _ := first.Make(ctx, "My Post"); slug != "my-post" {
+		t.Fatalf("first run Make = %q, expected %q", slug, "my-post")
+	}
+
+	second := slugcraft.New(slugcraft.WithUseCache(true), slugcraft.WithStore(openStore(t, path)))
+	if slug, _ := second.Make(ctx, "My Post"); slug != "my-post-1" {
+		t.Errorf("second run Make = %q, expected %q", slug, "my-post-1")
+	}
+}
+
+// TestSharedFile tests two stores on the same file, as two processes would use it.
+func TestSharedFile(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "slugs.log")
+	stores := []*Store{openStore(t, path), openStore(t, path)}
+	ctx := context.Background()
+
+	const perStore = 50
+	var wg sync.WaitGroup
+	var mu sync.Mutex
+	reserved := 0
+	for _, s := range stores {
+		for i := 0; i < perStore; i++ {
+			wg.Add(1)
+			go func(s *Store) {
+				defer wg.Done()
+				ok, err := s.Reserve(ctx, "shared")
+				if err != nil {
+					t.Errorf("Reserve returned error: %v", err)
+				}
+				if ok {
+					mu.Lock()
+					reserved++
+					mu.Unlock()
+				}
+			}(s)
+		}
+	}
+	wg.Wait()
+	if reserved != 1 {
+		t.Errorf("slug reserved %d times, expected 1", reserved)
+	}
+}
+
+// TestCompact tests that compaction drops released slugs and keeps live ones.
+func TestCompact(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "slugs.log")
+	s := openStore(t, path, WithCompactThreshold(0))
+	other := openStore(t, path)
+	ctx := context.Background()
+
+	for _, slug := range []string{"a", "b", "c", "d"} {
+		if _, err := s.Reserve(ctx, slug); err != nil {
+			t.Fatalf("Reserve(%q) returned error: %v", slug, err)
+		}
+	}
+	for _, slug := range []string{"a", "c"} {
+		if err := s.Release(ctx, slug); err != nil {
+			t.Fatalf("Release(%q) returned error: %v", slug, err)
+		}
+	}
+	before, _ := os.Stat(path)
+	if err := s.Compact(ctx); err != nil {
+		t.Fatalf("Compact returned error: %v", err)
+	}
+	after, _ := os.Stat(path)
+	if after.Size() >= before.Size() {
+		t.Errorf("log size after compaction = %d, expected < %d", after.Size(), before.Size())
+	}
+
+	// The other store must notice the rewritten file
+	for slug, expected := range map[string]bool{"a": false, "b": true, "c": false, "d": true} {
+		if exists, _ := other.Exists(ctx, slug); exists != expected {
+			t.Errorf("Exists(%q) after compaction = %v, expected %v", slug, exists, expected)
+		}
+	}
+	if ok, _ := other.Reserve(ctx, "e"); !ok {
+		t.Errorf("Reserve('e') after compaction = false, expected true")
+	}
+	if exists, _ := s.Exists(ctx, "e"); !exists {
+		t.Errorf("Exists('e') = false, expected true")
+	}
+}
+
+// TestAutoCompact tests that releases trigger compaction past the threshold.
+func TestAutoCompact(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "slugs.log")
+	s := openStore(t, path, WithCompactThreshold(4))
+	ctx := context.Background()
+
+	s.Reserve(ctx, "keep")
+	for _, slug := range []string{"x", "y"} {
+		s.Reserve(ctx, slug)
+		s.Release(ctx, slug)
+	}
+	data, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatalf("ReadFile returned error: %v", err)
+	}
+	if string(data) != "+keep\n" {
+		t.Errorf("log after auto compaction = %q, expected %q", data, "+keep\n")
+	}
+}
+
+// TestLeftoverLockFile tests that a lock file left behind by a crashed
+// process does not block the store.
+func TestLeftoverLockFile(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "slugs.log")
+	if err := os.WriteFile(path+".lock", nil, 0o644); err != nil {
+		t.Fatalf("WriteFile returned error: %v", err)
+	}
+	s := openStore(t, path)
+	if ok, err := s.Reserve(context.Background(), "hello"); err != nil || !ok {
+		t.Errorf("Reserve = %v, %v, expected true, nil", ok, err)
+	}
+}
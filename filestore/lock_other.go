@@ -0,0 +1,14 @@
+//go:build !unix && !windows
+
+package filestore
+
+import (
+	"context"
+	"errors"
+)
+
+// lockFile fails on platforms without advisory file locks, where the store
+// could not keep other processes out.
+func lockFile(context.Context, string) (func(), error) {
+	return nil, errors.ErrUnsupported
+}
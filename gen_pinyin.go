@@ -0,0 +1,95 @@
+//go:build ignore
+
+// gen_pinyin converts a code point to pinyin table (the pinyin_dict.go format
+// of github.com/mozillazg/go-pinyin, lines like `0x4E2D: "zhōng,zhòng",`)
+// into the compressed table embedded as data/pinyin.gz. Only the first,
+// most common reading of each character is kept, without tone marks. With
+// -module, -src names a file inside that module, which is fetched with go mod
+// download.
+//
+// Usage: go run gen_pinyin.go [-module path@version] -src pinyin_dict.go
+package main
+
+import (
+	"bufio"
+	"compress/gzip"
+	"flag"
+	"fmt"
+	"log"
+	"os"
+	"regexp"
+	"strconv"
+	"strings"
+	"unicode"
+
+	"github.com/mnuddindev/slugcraft/internal/gendata"
+	"golang.org/x/text/runes"
+	"golang.org/x/text/transform"
+	"golang.org/x/text/unicode/norm"
+)
+
+var entry = regexp.MustCompile(`^\s*0x([0-9A-Fa-f]+): "([^"]+)",`)
+
+func main() {
+	module := flag.String("module", "", "Module (path@version) to fetch -src from")
+	src := flag.String("src", "", "pinyin_dict.go to convert")
+	dst := flag.String("dst", "data/pinyin.gz", "Output file")
+	flag.Parse()
+	if *src == "" {
+		log.Fatal("missing -src")
+	}
+
+	path, err := gendata.Source(*module, *src)
+	if err != nil {
+		log.Fatal(err)
+	}
+	in, err := os.Open(path)
+	if err != nil {
+		log.Fatal(err)
+	}
+	defer in.Close()
+	out, err := os.Create(*dst)
+	if err != nil {
+		log.Fatal(err)
+	}
+	defer out.Close()
+	zw, err := gzip.NewWriterLevel(out, gzip.BestCompression)
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	// ü is kept apart from u, as in passport spelling (lü -> lyu)
+	untone := transform.Chain(norm.NFD, runes.Remove(runes.Predicate(func(r rune) bool {
+		return unicode.Is(unicode.Mn, r) && r != '̈'
+	})), norm.NFC)
+
+	scanner := bufio.NewScanner(in)
+	for scanner.Scan() {
+		m := entry.FindStringSubmatch(scanner.Text())
+		if m == nil {
+			continue
+		}
+		code, err := strconv.ParseUint(m[1], 16, 32)
+		if err != nil {
+			log.Fatal(err)
+		}
+		reading, _, _ := strings.Cut(m[2], ",")
+		plain, _, err := transform.String(untone, reading)
+		if err != nil {
+			log.Fatal(err)
+		}
+		plain = strings.ReplaceAll(plain, "ü", "yu")
+		for _, r := range plain {
+			if r < 'a' || r > 'z' {
+				log.Fatalf("unexpected reading %q for U+%04X", reading, code)
+			}
+		}
+		fmt.Fprintf(zw, "%x\t%s\n", code, plain)
+	}
+	if err := scanner.Err(); err != nil {
+		log.Fatal(err)
+	}
+	if err := zw.Close(); err != nil {
+		log.Fatal(err)
+	}
+}
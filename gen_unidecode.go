@@ -0,0 +1,82 @@
+//go:build ignore
+
+// gen_unidecode converts a Text::Unidecode table dump (the table.txt format
+// used by the Go unidecode ports, lines like `0x4eac: "Jing "`) into the
+// compressed table embedded as data/unidecode.gz. With -module, -src names a
+// file inside that module, which is fetched with go mod download.
+//
+// Usage: go run gen_unidecode.go [-module path@version] -src table.txt
+package main
+
+import (
+	"bufio"
+	"compress/gzip"
+	"flag"
+	"fmt"
+	"log"
+	"os"
+	"strconv"
+	"strings"
+
+	"github.com/mnuddindev/slugcraft/internal/gendata"
+)
+
+func main() {
+	module := flag.String("module", "", "Module (path@version) to fetch -src from")
+	src := flag.String("src", "", "Text::Unidecode table dump to convert")
+	dst := flag.String("dst", "data/unidecode.gz", "Output file")
+	flag.Parse()
+	if *src == "" {
+		log.Fatal("missing -src")
+	}
+
+	path, err := gendata.Source(*module, *src)
+	if err != nil {
+		log.Fatal(err)
+	}
+	in, err := os.Open(path)
+	if err != nil {
+		log.Fatal(err)
+	}
+	defer in.Close()
+	out, err := os.Create(*dst)
+	if err != nil {
+		log.Fatal(err)
+	}
+	defer out.Close()
+	zw, err := gzip.NewWriterLevel(out, gzip.BestCompression)
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	scanner := bufio.NewScanner(in)
+	for scanner.Scan() {
+		line := scanner.Text()
+		if line == "" || strings.HasPrefix(line, "/*") {
+			continue
+		}
+		key, value, ok := strings.Cut(line, ": ")
+		if !ok {
+			log.Fatalf("malformed line %q", line)
+		}
+		code, err := strconv.ParseUint(strings.TrimPrefix(key, "0x"), 16, 32)
+		if err != nil {
+			log.Fatalf("malformed code point in %q: %v", line, err)
+		}
+		ascii, err := strconv.Unquote(value)
+		if err != nil {
+			log.Fatalf("malformed value in %q: %v", line, err)
+		}
+		// ASCII maps to itself and "[?]" marks unknown code points
+		if code < 0x80 || ascii == "" || ascii == "[?]" {
+			continue
+		}
+		fmt.Fprintf(zw, "%04x\t%s\n", code, strconv.Quote(ascii))
+	}
+	if err := scanner.Err(); err != nil {
+		log.Fatal(err)
+	}
+	if err := zw.Close(); err != nil {
+		log.Fatal(err)
+	}
+}
@@ -2,4 +2,7 @@ module github.com/mnuddindev/slugcraft
 
 go 1.24.0
 
-require golang.org/x/text v0.23.0
+require (
+	golang.org/x/sys v0.35.0
+	golang.org/x/text v0.23.0
+)
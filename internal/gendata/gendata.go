@@ -0,0 +1,40 @@
+// Package gendata holds helpers shared by the go:generate programs that build
+// the embedded data tables.
+package gendata
+
+import (
+	"encoding/json"
+	"fmt"
+	"os/exec"
+	"path/filepath"
+)
+
+// ModuleDir downloads mod (path@version) into the module cache if needed and
+// returns its directory.
+func ModuleDir(mod string) (string, error) {
+	out, err := exec.Command("go", "mod", "download", "-json", mod).Output()
+	var info struct{ Dir, Error string }
+	if jsonErr := json.Unmarshal(out, &info); jsonErr != nil {
+		if err != nil {
+			return "", fmt.Errorf("go mod download %s: %w", mod, err)
+		}
+		return "", jsonErr
+	}
+	if info.Error != "" {
+		return "", fmt.Errorf("go mod download %s: %s", mod, info.Error)
+	}
+	return info.Dir, nil
+}
+
+// Source returns the path of the generator input src: a file inside mod when
+// mod is set, or src itself otherwise.
+func Source(mod, src string) (string, error) {
+	if mod == "" {
+		return src, nil
+	}
+	dir, err := ModuleDir(mod)
+	if err != nil {
+		return "", err
+	}
+	return filepath.Join(dir, src), nil
+}
@@ -3,149 +3,2249 @@ package slugcraft
 import (
 	"strings"
 	"unicode"
+	"unicode/utf8"
+
+	"golang.org/x/text/runes"
+	"golang.org/x/text/transform"
+	"golang.org/x/text/unicode/norm"
+)
+
+// banglaScheme is one romanization of Bengali. Conjuncts are spelled letter
+// by letter, hasanta dropping out, unless letters lists the conjunct.
+type banglaScheme struct {
+	letters  map[string]string // Letters, signs, phalas (keyed with the hasanta) and conjuncts
+	initial  map[string]string // Spellings at the start of a word
+	inherent string            // The vowel of a consonant without a vowel sign
+	phonetic bool              // Drop the inherent vowel where it is not spoken
+	fold     bool              // Remove the diacritics the scheme writes, leaving ASCII
+}
+
+// banglaSchemes holds the supported Bangla romanizations.
+var banglaSchemes = map[string]*banglaScheme{
+	"banglish": {
+		letters: map[string]string{
+			// Vowels
+			"অ": "o", "আ": "a", "ই": "i", "ঈ": "ee", "উ": "u", "ঊ": "oo",
+			"ঋ": "ri", "এ": "e", "ঐ": "oi", "ও": "o", "ঔ": "ou",
+
+			// Consonants
+			"ক": "k", "খ": "kh", "গ": "g", "ঘ": "gh", "ঙ": "ng",
+			"চ": "ch", "ছ": "chh", "জ": "j", "ঝ": "jh", "ঞ": "ny",
+			"ট": "t", "ঠ": "th", "ড": "d", "ঢ": "dh", "ণ": "n",
+			"ত": "t", "থ": "th", "দ": "d", "ধ": "dh", "ন": "n",
+			"প": "p", "ফ": "ph", "ব": "b", "ভ": "bh", "ম": "m",
+			"য": "j", "র": "r", "ল": "l", "শ": "sh", "ষ": "sh", "স": "s", "হ": "h",
+
+			// Special Cases
+			"ৎ": "t", "ড়": "r", "ঢ়": "rh", "য়": "y", "ং": "ng", "ঃ": "h", "ঁ": "",
+
+			// Dependent Vowel Signs
+			"া": "a", "ি": "i", "ী": "ee", "ু": "u", "ূ": "oo", "ৃ": "ri",
+			"ে": "e", "ৈ": "oi", "ো": "o", "ৌ": "ou",
+
+			// Phalas and conjuncts read apart from their letters
+			"্র": "r", "্য": "y", "্ব": "w", "ক্ষ": "kkh", "জ্ঞ": "gg", "ঙ্গ": "ng",
+		},
+		initial:  map[string]string{"ক্ষ": "kh", "জ্ঞ": "gy"},
+		inherent: "o",
+		phonetic: true,
+	},
+	// IAST, writing every inherent vowel, with its diacritics folded to ASCII
+	// (ā -> a, ś -> s)
+	"iast": {
+		letters: map[string]string{
+			// Vowels
+			"অ": "a", "আ": "ā", "ই": "i", "ঈ": "ī", "উ": "u", "ঊ": "ū",
+			"ঋ": "ṛ", "এ": "e", "ঐ": "ai", "ও": "o", "ঔ": "au",
+
+			// Consonants
+			"ক": "k", "খ": "kh", "গ": "g", "ঘ": "gh", "ঙ": "ṅ",
+			"চ": "c", "ছ": "ch", "জ": "j", "ঝ": "jh", "ঞ": "ñ",
+			"ট": "ṭ", "ঠ": "ṭh", "ড": "ḍ", "ঢ": "ḍh", "ণ": "ṇ",
+			"ত": "t", "থ": "th", "দ": "d", "ধ": "dh", "ন": "n",
+			"প": "p", "ফ": "ph", "ব": "b", "ভ": "bh", "ম": "m",
+			"য": "y", "র": "r", "ল": "l", "শ": "ś", "ষ": "ṣ", "স": "s", "হ": "h",
+
+			// Special Cases
+			"ৎ": "t", "ড়": "ṛ", "ঢ়": "ṛh", "য়": "y", "ং": "ṃ", "ঃ": "ḥ", "ঁ": "",
+
+			// Dependent Vowel Signs
+			"া": "ā", "ি": "i", "ী": "ī", "ু": "u", "ূ": "ū", "ৃ": "ṛ",
+			"ে": "e", "ৈ": "ai", "ো": "o", "ৌ": "au",
+
+			// Phalas
+			"্র": "r", "্য": "y", "্ব": "v",
+		},
+		inherent: "a",
+		fold:     true,
+	},
+}
+
+const (
+	banglaHasanta = '্'
+	zeroWidthNJ   = '\u200c'
+	zeroWidthJ    = '\u200d'
 )
 
 // TransliterateBangla converts Bengali text to Banglish.
 func TransliterateBangla(input string, b *strings.Builder) string {
-	runes := []rune(input)
-	bengaliToBanglish := map[string]string{
-		// Vowels
-		"অ": "o", "আ": "a", "ই": "i", "ঈ": "ee", "উ": "u", "ঊ": "oo",
-		"এ": "e", "ঐ": "oi", "ও": "o", "ঔ": "ou",
+	transliterateBangla(input, b, banglaSchemes["banglish"])
+	return b.String()
+}
 
-		// Consonants
-		"ক": "k", "খ": "kh", "গ": "g", "ঘ": "gh", "ঙ": "ng",
-		"চ": "ch", "ছ": "chh", "জ": "j", "ঝ": "jh", "ঞ": "ny",
-		"ট": "t", "ঠ": "th", "ড": "d", "ঢ": "dh", "ণ": "n",
-		"ত": "t", "থ": "th", "দ": "d", "ধ": "dh", "ন": "n",
-		"প": "p", "ফ": "ph", "ব": "b", "ভ": "bh", "ম": "m",
-		"য": "j", "র": "r", "ল": "l", "শ": "sh", "ষ": "sh", "স": "s", "হ": "h",
+// isBanglaConsonant reports whether r is a Bengali consonant letter.
+func isBanglaConsonant(r rune) bool {
+	return (r >= 'ক' && r <= 'হ') || r == 'ড়' || r == 'ঢ়' || r == 'য়'
+}
 
-		// Special Cases
-		"ৎ": "t", "ড়": "r", "ঢ়": "rh", "য়": "yo", "ং": "ng",
+// isBanglaWordRune reports whether r belongs inside a Bengali word: a
+// letter or sign, but not a digit or currency sign.
+func isBanglaWordRune(r rune) bool {
+	return unicode.Is(unicode.Bengali, r) && !(r >= '০' && r <= '৯') && !(r >= 'ৰ' && r <= '৾') ||
+		r == zeroWidthNJ || r == zeroWidthJ
+}
 
-		// Dependent Vowel Signs
-		"া": "a", "ি": "i", "ী": "ee", "ু": "u", "ূ": "oo",
-		"ে": "e", "ৈ": "oi", "ো": "o", "ৌ": "ou", "্র": "r",
+// transliterateBangla romanizes input with s word by word. Bengali digits
+// become ASCII digits and the danda a space.
+func transliterateBangla(input string, b *strings.Builder, s *banglaScheme) {
+	// NFC composes split vowel signs and decomposes the nukta letters, which
+	// the tables hold precomposed
+	var runes []rune
+	for _, r := range norm.NFC.String(input) {
+		if r == '়' && len(runes) > 0 {
+			switch runes[len(runes)-1] {
+			case 'ড':
+				runes[len(runes)-1] = 'ড়'
+				continue
+			case 'ঢ':
+				runes[len(runes)-1] = 'ঢ়'
+				continue
+			case 'য':
+				runes[len(runes)-1] = 'য়'
+				continue
+			}
+		}
+		runes = append(runes, r)
+	}
 
-		// Jukto Borno (Conjunct Consonants)
-		"ক্ত": "kt", "গ্ন": "gn", "স্ট": "st", "স্প": "sp", "শ্চ": "sch", "স্ফ": "sph",
-		"স্ত": "st", "স্ত্র": "str", "ন্ত্র": "ntr", "ম্প": "mp", "ন্ড": "nd",
-		"ঙ্ক": "nk", "ঙ্গ": "ngg", "ষ্ক": "shk", "ষ্ঠ": "shth", "ক্ষ": "kho",
+	for i := 0; i < len(runes); {
+		r := runes[i]
+		switch {
+		case r >= '০' && r <= '৯':
+			b.WriteRune('0' + r - '০')
+			i++
+		case r == '।' || r == '॥':
+			b.WriteByte(' ')
+			i++
+		case isBanglaWordRune(r):
+			j := i
+			var word []rune
+			for ; j < len(runes) && isBanglaWordRune(runes[j]); j++ {
+				if runes[j] != zeroWidthNJ && runes[j] != zeroWidthJ {
+					word = append(word, runes[j])
+				}
+			}
+			if s.fold {
+				var w strings.Builder
+				writeBanglaWord(word, &w, s)
+				b.WriteString(removeMarks(w.String()))
+			} else {
+				writeBanglaWord(word, b, s)
+			}
+			i = j
+		default:
+			b.WriteRune(r)
+			i++
+		}
 	}
+}
+
+// banglaSyllable is a vowel, or a consonant cluster with its vowel sign,
+// followed by the sounds that close it.
+type banglaSyllable struct {
+	onset    []rune // Consonants joined by hasanta
+	vowel    rune   // Vowel sign or independent vowel, 0 when inherent
+	coda     string // Spelled consonants and signs closing the syllable
+	conjunct bool   // The onset was part of a cluster
+	killed   bool   // A hasanta silences the inherent vowel
+}
+
+// spell writes the cluster c, preferring conjuncts the scheme lists.
+func (s *banglaScheme) spell(c []rune, wordStart bool) string {
+	var sb strings.Builder
+	for i := 0; i < len(c); {
+		if i+3 <= len(c) {
+			key := string(c[i : i+3])
+			if v, ok := s.initial[key]; ok && wordStart && i == 0 {
+				sb.WriteString(v)
+				i += 3
+				continue
+			}
+			if v, ok := s.letters[key]; ok {
+				sb.WriteString(v)
+				i += 3
+				continue
+			}
+		}
+		if c[i] == banglaHasanta {
+			if i+1 < len(c) {
+				if v, ok := s.letters[string(c[i:i+2])]; ok {
+					sb.WriteString(v)
+					i += 2
+					continue
+				}
+			}
+			i++
+			continue
+		}
+		if v, ok := s.letters[string(c[i])]; ok {
+			sb.WriteString(v)
+		} else {
+			sb.WriteRune(c[i])
+		}
+		i++
+	}
+	return sb.String()
+}
+
+// splits reports whether the first consonant of cluster c closes the
+// syllable before it. Phalas and listed conjuncts stay with the onset.
+func (s *banglaScheme) splits(c []rune) bool {
+	if len(c) < 3 {
+		return false
+	}
+	if _, ok := s.letters[string(c[:3])]; ok {
+		return false
+	}
+	if c[0] == 'র' {
+		// Reph
+		return true
+	}
+	switch c[2] {
+	case 'র', 'য':
+		return false
+	case 'ব':
+		return c[0] == 'ম'
+	}
+	return true
+}
+
+// writeBanglaWord splits word into syllables and writes them, adding the
+// inherent vowel where it is read.
+func writeBanglaWord(word []rune, b *strings.Builder, s *banglaScheme) {
+	var syl []banglaSyllable
+	geminate := false
+	for i := 0; i < len(word); {
+		r := word[i]
+		switch {
+		case isBanglaConsonant(r):
+			start := i
+			for i++; i+1 < len(word) && word[i] == banglaHasanta && isBanglaConsonant(word[i+1]); i += 2 {
+			}
+			c := word[start:i]
+			sy := banglaSyllable{conjunct: len(c) > 1 || geminate}
+			if geminate {
+				// Visarga doubles the consonant after it
+				syl[len(syl)-1].coda += s.spell(c[:1], false)[:1]
+				geminate = false
+			}
+			if len(syl) > 0 && s.splits(c) {
+				syl[len(syl)-1].coda += s.spell(c[:1], false)
+				c = c[2:]
+			}
+			sy.onset = c
+			switch {
+			case i < len(word) && word[i] == banglaHasanta:
+				sy.killed = true
+				i++
+			case i < len(word) && word[i] >= 'া' && word[i] <= 'ৌ':
+				sy.vowel = word[i]
+				i++
+			}
+			syl = append(syl, sy)
+		case r >= 'অ' && r <= 'ঔ':
+			syl = append(syl, banglaSyllable{vowel: r})
+			i++
+		case len(syl) == 0:
+			b.WriteString(s.letters[string(r)])
+			i++
+		case r == 'ঃ' && s.phonetic && i+1 < len(word) && isBanglaConsonant(word[i+1]):
+			geminate = true
+			i++
+		default:
+			// Khanda ta, anusvara, visarga and chandrabindu close the syllable
+			syl[len(syl)-1].coda += s.letters[string(r)]
+			i++
+		}
+	}
+
+	for k, sy := range syl {
+		if sy.onset != nil {
+			b.WriteString(s.spell(sy.onset, k == 0))
+		}
+		if sy.vowel != 0 {
+			b.WriteString(s.letters[string(sy.vowel)])
+		} else if !sy.killed && s.readsInherent(syl, k) {
+			b.WriteString(s.inherent)
+		}
+		b.WriteString(sy.coda)
+	}
+}
+
+// readsInherent reports whether the consonant syllable k of syl keeps its
+// inherent vowel. Phonetic schemes drop it at the end of a word after a
+// single consonant, and in the middle of a word between a syllable with a
+// vowel sign or the first inherent vowel, and one with a vowel sign.
+func (s *banglaScheme) readsInherent(syl []banglaSyllable, k int) bool {
+	sy := syl[k]
+	if !s.phonetic || k == 0 || sy.conjunct || sy.coda != "" {
+		return true
+	}
+	if k == len(syl)-1 {
+		// প্রিয় is priyo, but সময় is somoy
+		prev := syl[k-1].vowel
+		return sy.onset[0] == 'য়' && (prev == 'ি' || prev == 'ী' || prev == 'ই' || prev == 'ঈ')
+	}
+	prev, next := syl[k-1], syl[k+1]
+	return prev.coda != "" || prev.vowel == 0 && k > 1 || next.onset != nil && next.onset[0] == 'য়' ||
+		next.vowel == 0 && next.coda == ""
+}
+
+// TransliterateRussian romanizes Russian text with the "simple" scheme,
+// keeping the case of the input.
+func TransliterateRussian(input string, b *strings.Builder) {
+	transliterateCyrillic(input, b, russianSchemes["simple"])
+}
+
+// greekBase maps accented Greek vowels to their plain form; greekDiaeresis
+// marks the ones whose diaeresis keeps them from forming a digraph.
+var (
+	greekBase = map[rune]rune{
+		'ά': 'α', 'έ': 'ε', 'ή': 'η', 'ί': 'ι', 'ό': 'ο', 'ύ': 'υ', 'ώ': 'ω',
+		'ϊ': 'ι', 'ΐ': 'ι', 'ϋ': 'υ', 'ΰ': 'υ', 'ς': 'σ',
+	}
+	greekDiaeresis = map[rune]bool{'ϊ': true, 'ΐ': true, 'ϋ': true, 'ΰ': true}
+	greekToLatin   = map[rune]string{
+		'α': "a", 'β': "v", 'γ': "g", 'δ': "d", 'ε': "e", 'ζ': "z", 'η': "i", 'θ': "th",
+		'ι': "i", 'κ': "k", 'λ': "l", 'μ': "m", 'ν': "n", 'ξ': "x", 'ο': "o", 'π': "p",
+		'ρ': "r", 'σ': "s", 'τ': "t", 'υ': "y", 'φ': "f", 'χ': "ch", 'ψ': "ps", 'ω': "o",
+	}
+)
+
+// TransliterateGreek converts Greek text to Latin following ELOT 743.
+func TransliterateGreek(input string, b *strings.Builder) {
+	runes := []rune(strings.ToLower(input))
+	dia := make([]bool, len(runes))
+	for i, r := range runes {
+		dia[i] = greekDiaeresis[r]
+		if base, ok := greekBase[r]; ok {
+			runes[i] = base
+		}
+	}
+	at := func(i int) rune {
+		if i < 0 || i >= len(runes) {
+			return 0
+		}
+		return runes[i]
+	}
+	isVowel := func(r rune) bool { return strings.ContainsRune("αεηιουω", r) }
+	isVoiced := func(r rune) bool { return strings.ContainsRune("βγδζλμνρ", r) }
 
 	for i := 0; i < len(runes); {
-		// Check for three-rune conjunct (e.g., স্ত্র)
-		if i+2 < len(runes) {
-			triplet := string(runes[i : i+3])
-			if mapped, ok := bengaliToBanglish[triplet]; ok {
+		r, next := runes[i], at(i+1)
+		wordStart := !unicode.IsLetter(at(i - 1))
+
+		switch {
+		// αυ, ευ, ηυ sound as av/ev/iv before vowels and voiced consonants
+		case strings.ContainsRune("αεη", r) && next == 'υ' && !dia[i+1]:
+			b.WriteString(greekToLatin[r])
+			if after := at(i + 2); isVowel(after) || isVoiced(after) {
+				b.WriteByte('v')
+			} else {
+				b.WriteByte('f')
+			}
+			i += 2
+		case r == 'ο' && next == 'υ' && !dia[i+1]:
+			b.WriteString("ou")
+			i += 2
+		case r == 'μ' && next == 'π':
+			if wordStart {
+				b.WriteString("b")
+			} else {
+				b.WriteString("mp")
+			}
+			i += 2
+		case r == 'ν' && next == 'τ':
+			if wordStart {
+				b.WriteString("d")
+			} else {
+				b.WriteString("nt")
+			}
+			i += 2
+		case r == 'γ' && next == 'κ':
+			if wordStart {
+				b.WriteString("g")
+			} else {
+				b.WriteString("gk")
+			}
+			i += 2
+		case r == 'γ' && (next == 'γ' || next == 'ξ' || next == 'χ'):
+			b.WriteString("n")
+			b.WriteString(greekToLatin[next])
+			i += 2
+		default:
+			if mapped, ok := greekToLatin[r]; ok {
 				b.WriteString(mapped)
-				i += 3
+			} else if !unicode.Is(unicode.Greek, r) {
+				b.WriteRune(r)
+			}
+			i++
+		}
+	}
+}
+
+// arabicSchemes holds the supported Arabic romanization tables.
+var arabicSchemes = map[string]map[rune]string{
+	// simple is the consonant-based romanization common in news URLs
+	"simple": {
+		'ا': "a", 'أ': "a", 'إ': "i", 'آ': "aa", 'ٱ': "a", 'ب': "b", 'ت': "t", 'ث': "th",
+		'ج': "j", 'ح': "h", 'خ': "kh", 'د': "d", 'ذ': "dh", 'ر': "r", 'ز': "z", 'س': "s",
+		'ش': "sh", 'ص': "s", 'ض': "d", 'ط': "t", 'ظ': "z", 'ع': "", 'غ': "gh", 'ف': "f",
+		'ق': "q", 'ك': "k", 'ل': "l", 'م': "m", 'ن': "n", 'ه': "h", 'و': "w", 'ي': "y",
+		'ى': "a", 'ة': "a", 'ء': "", 'ؤ': "", 'ئ': "", 'پ': "p", 'چ': "ch", 'گ': "g",
+		'ڤ': "v", 'ک': "k", 'ی': "y",
+	},
+	// arabizi is the chat alphabet that writes some sounds as digits
+	"arabizi": {
+		'ا': "a", 'أ': "2a", 'إ': "2i", 'آ': "2aa", 'ٱ': "a", 'ب': "b", 'ت': "t", 'ث': "th",
+		'ج': "j", 'ح': "7", 'خ': "kh", 'د': "d", 'ذ': "dh", 'ر': "r", 'ز': "z", 'س': "s",
+		'ش': "sh", 'ص': "s", 'ض': "d", 'ط': "t", 'ظ': "z", 'ع': "3", 'غ': "gh", 'ف': "f",
+		'ق': "q", 'ك': "k", 'ل': "l", 'م': "m", 'ن': "n", 'ه': "h", 'و': "w", 'ي': "y",
+		'ى': "a", 'ة': "a", 'ء': "2", 'ؤ': "2", 'ئ': "2", 'پ': "p", 'چ': "ch", 'گ': "g",
+		'ڤ': "v", 'ک': "k", 'ی': "y",
+	},
+}
+
+// arabicSunLetters assimilate the l of the definite article.
+const arabicSunLetters = "تثدذرزسشصضطظلن"
+
+// isArabicMark reports whether r is a haraka, Quranic annotation or tatweel.
+func isArabicMark(r rune) bool {
+	return (r >= 0x064B && r <= 0x065F) || r == 0x0670 || r == 0x0640 ||
+		(r >= 0x06D6 && r <= 0x06ED)
+}
+
+// TransliterateArabic converts Arabic text to Latin with the "simple" scheme.
+func TransliterateArabic(input string, b *strings.Builder) {
+	transliterateArabic(input, b, arabicSchemes["simple"])
+}
+
+// transliterateArabic strips harakat and tatweel, assimilates the article
+// before sun letters, doubles consonants marked with shadda, reads و and ي
+// between consonants as long vowels and maps Arabic-Indic digits to ASCII.
+func transliterateArabic(input string, b *strings.Builder, table map[rune]string) {
+	const shadda = 'ّ'
+	var runes []rune
+	var doubled []bool
+	for _, r := range input {
+		switch {
+		case r == shadda:
+			if len(doubled) > 0 {
+				doubled[len(doubled)-1] = true
+			}
+		case isArabicMark(r):
+		default:
+			runes = append(runes, r)
+			doubled = append(doubled, false)
+		}
+	}
+	isLetter := func(i int) bool {
+		return i >= 0 && i < len(runes) && unicode.IsLetter(runes[i])
+	}
+
+	// afterConsonant tracks whether the last letter written was a consonant,
+	// which turns a following و or ي into a long vowel
+	afterConsonant := false
+	for i := 0; i < len(runes); i++ {
+		r := runes[i]
+		wordStart := !isLetter(i - 1)
+		wasConsonant := afterConsonant
+		afterConsonant = isLetter(i) && !strings.ContainsRune("اأإآٱى", r)
+
+		// Definite article al-, assimilated before sun letters
+		if wordStart && r == 'ا' && i+2 < len(runes) && runes[i+1] == 'ل' && isLetter(i+2) {
+			next := runes[i+2]
+			if strings.ContainsRune(arabicSunLetters, next) {
+				b.WriteString("a" + table[next] + "-" + table[next])
+				doubled[i+2] = false
+				i += 2
+			} else {
+				b.WriteString("al-")
+				i++
+			}
+			continue
+		}
+
+		switch {
+		case r >= '٠' && r <= '٩':
+			b.WriteRune('0' + r - '٠')
+		case r >= '۰' && r <= '۹':
+			b.WriteRune('0' + r - '۰')
+		case r == '،' || r == '؛' || r == '؟':
+			b.WriteByte(' ')
+		case (r == 'و' || r == 'ي') && !wordStart && wasConsonant && !doubled[i]:
+			afterConsonant = false
+			if r == 'و' {
+				b.WriteString("u")
+			} else {
+				b.WriteString("i")
+			}
+		default:
+			mapped, ok := table[r]
+			if !ok {
+				if !unicode.Is(unicode.Arabic, r) {
+					b.WriteRune(r)
+				}
+				continue
+			}
+			b.WriteString(mapped)
+			if doubled[i] {
+				b.WriteString(mapped)
+			}
+		}
+	}
+}
+
+// hebrewScript holds the reading of the Hebrew alphabet for one language.
+// letters is keyed by a letter alone or with the point that changes it.
+type hebrewScript struct {
+	letters  map[string]string
+	digraphs map[string]string // Letter pairs read as one sound
+	geresh   map[rune]string   // Letters marked with a geresh for foreign sounds
+	points   map[rune]string   // Vowel points; nil when points only change letters
+}
+
+var (
+	// hebrewScriptHe follows the simplified romanization of the Academy of
+	// the Hebrew Language, as used on road signs
+	hebrewScriptHe = &hebrewScript{
+		letters: map[string]string{
+			"א": "", "ב": "v", "בּ": "b", "ג": "g", "ד": "d", "ה": "h", "ו": "v", "וּ": "u", "וֹ": "o",
+			"ז": "z", "ח": "h", "ט": "t", "י": "y", "כ": "kh", "כּ": "k", "ך": "kh", "ל": "l",
+			"מ": "m", "ם": "m", "נ": "n", "ן": "n", "ס": "s", "ע": "", "פ": "f", "פּ": "p", "ף": "f",
+			"צ": "ts", "ץ": "ts", "ק": "k", "ר": "r", "ש": "sh", "שׁ": "sh", "שׂ": "s", "ת": "t",
+		},
+		geresh: map[rune]string{'ג': "j", 'ז': "zh", 'צ': "ch", 'ץ': "ch", 'ת': "th"},
+		points: map[rune]string{
+			'ְ': "", 'ֱ': "e", 'ֲ': "a", 'ֳ': "o", 'ִ': "i", 'ֵ': "e", 'ֶ': "e", 'ַ': "a",
+			'ָ': "a", 'ֹ': "o", 'ֺ': "o", 'ֻ': "u", 'ׇ': "o",
+		},
+	}
+	// hebrewScriptYi follows YIVO, which spells every vowel
+	hebrewScriptYi = &hebrewScript{
+		letters: map[string]string{
+			"א": "", "אַ": "a", "אָ": "o", "ב": "b", "בֿ": "v", "ג": "g", "ד": "d", "ה": "h",
+			"ו": "u", "וּ": "u", "ז": "z", "ח": "kh", "ט": "t", "י": "i", "יִ": "i", "כ": "kh",
+			"כּ": "k", "ך": "kh", "ל": "l", "מ": "m", "ם": "m", "נ": "n", "ן": "n", "ס": "s",
+			"ע": "e", "פ": "f", "פּ": "p", "פֿ": "f", "ף": "f", "צ": "ts", "ץ": "ts", "ק": "k",
+			"ר": "r", "ש": "sh", "שׂ": "s", "ת": "s", "תּ": "t", "װ": "v", "ױ": "oy", "ײ": "ey",
+			"ײַ": "ay",
+		},
+		digraphs: map[string]string{"וו": "v", "וי": "oy", "יי": "ey", "זש": "zh"},
+	}
+)
+
+// isHebrewLetter reports whether r is a letter of the Hebrew alphabet,
+// including the Yiddish ligatures.
+func isHebrewLetter(r rune) bool {
+	return (r >= 'א' && r <= 'ת') || (r >= 'װ' && r <= 'ײ')
+}
+
+// isBidiControl reports whether r is an invisible direction mark or
+// embedding, which editors paste around right-to-left text.
+func isBidiControl(r rune) bool {
+	return r == '‎' || r == '‏' || r == '؜' ||
+		(r >= '‪' && r <= '‮') || (r >= '⁦' && r <= '⁩')
+}
+
+// TransliterateHebrew romanizes Hebrew following the simplified rules of
+// the Academy of the Hebrew Language.
+func TransliterateHebrew(input string, b *strings.Builder) {
+	transliterateHebrew(input, b, hebrewScriptHe)
+}
+
+// TransliterateYiddish romanizes Yiddish following YIVO.
+func TransliterateYiddish(input string, b *strings.Builder) {
+	transliterateHebrew(input, b, hebrewScriptYi)
+}
+
+// hebrewUnit is a letter with the points written on it.
+type hebrewUnit struct {
+	r             rune
+	vowel, letter rune // Vowel point and the dot or dagesh changing the letter
+	geresh        bool
+}
+
+// transliterateHebrew strips cantillation and bidi controls, spells letters
+// with their dagesh, dots and geresh, and folds final forms. Unpointed
+// Hebrew is read from its vowel letters: ו and י between consonants as o and
+// i, and a word-final ה as a.
+func transliterateHebrew(input string, b *strings.Builder, s *hebrewScript) {
+	// NFC splits precomposed presentation forms and orders the points
+	var units []hebrewUnit
+	for _, r := range norm.NFC.String(input) {
+		var last *hebrewUnit
+		if len(units) > 0 && isHebrewLetter(units[len(units)-1].r) {
+			last = &units[len(units)-1]
+		}
+		switch {
+		case isBidiControl(r), r >= 0x0591 && r <= 0x05AF, r == 'ֽ', r == 'ׄ', r == 'ׅ':
+			// Cantillation and meteg
+		case r == 'ּ' || r == 'ֿ' || r == 'ׁ' || r == 'ׂ':
+			if last != nil && (last.letter == 0 || last.letter == 'ּ') {
+				last.letter = r
+			}
+		case r >= 'ְ' && r <= 'ֻ' || r == 'ׇ':
+			if last != nil {
+				last.vowel = r
+			}
+		case r == '׳' || r == '\'' || r == '’':
+			if last != nil {
+				last.geresh = true
+			} else {
+				units = append(units, hebrewUnit{r: r})
+			}
+		case r == '״' || r == '"':
+			// Gershayim mark acronyms and are dropped between letters
+			if last == nil {
+				units = append(units, hebrewUnit{r: r})
+			}
+		case r == '־' || r == '׀' || r == '׃' || r == '׆':
+			units = append(units, hebrewUnit{r: ' '})
+		default:
+			units = append(units, hebrewUnit{r: r})
+		}
+	}
+
+	n := len(units)
+	isLetter := func(i int) bool { return i >= 0 && i < n && isHebrewLetter(units[i].r) }
+	bare := func(i int) bool { return isLetter(i) && units[i].vowel == 0 && units[i].letter == 0 }
+	pointed := false
+	for _, u := range units {
+		pointed = pointed || u.vowel != 0
+	}
+
+	lastVowel := ""
+	for i := 0; i < n; i++ {
+		u := units[i]
+		if !isLetter(i) {
+			lastVowel = ""
+			if u.r == '\'' || u.r == '’' || u.r == '"' || !unicode.Is(unicode.Hebrew, u.r) {
+				b.WriteRune(u.r)
+			}
+			continue
+		}
+		wordStart, wordEnd := !isLetter(i-1), !isLetter(i+1)
+
+		if u.geresh {
+			if spelled, ok := s.geresh[u.r]; ok {
+				b.WriteString(spelled)
+				lastVowel = s.points[u.vowel]
+				b.WriteString(lastVowel)
+				continue
+			}
+		}
+		if s.digraphs != nil && bare(i) && bare(i+1) {
+			if spelled, ok := s.digraphs[string([]rune{u.r, units[i+1].r})]; ok {
+				b.WriteString(spelled)
+				i++
 				continue
 			}
 		}
 
-		// Check for two-rune conjunct (e.g., ক্ত)
+		spelled, ok := s.letters[string([]rune{u.r, u.vowel})]
+		vowel := ""
+		if !ok {
+			vowel = s.points[u.vowel]
+			if u.vowel == 'ְ' && wordStart {
+				// Sheva on the first letter is voiced
+				vowel = "e"
+			}
+			if spelled, ok = s.letters[string([]rune{u.r, u.letter})]; !ok {
+				spelled = s.letters[string(u.r)]
+			}
+		}
+
+		if s.points != nil && bare(i) {
+			// Hebrew written without points
+			switch u.r {
+			case 'א', 'ע':
+				if wordStart && !wordEnd && !strings.ContainsRune("וי", units[i+1].r) {
+					spelled = "a"
+				}
+			case 'ב', 'כ', 'פ':
+				if wordStart {
+					spelled = s.letters[string([]rune{u.r, 'ּ'})]
+				}
+			case 'ו':
+				switch {
+				case pointed || wordStart:
+				case bare(i+1) && units[i+1].r == 'ו':
+					i++
+				default:
+					spelled = "o"
+				}
+			case 'י':
+				switch {
+				case pointed && (lastVowel == "i" || lastVowel == "e"):
+					spelled = ""
+				case wordStart || isLetter(i+1) && units[i+1].vowel != 0:
+				case bare(i+1) && units[i+1].r == 'י':
+					i++
+				case !pointed || lastVowel == "":
+					spelled = "i"
+				}
+			case 'ה':
+				if wordEnd && !wordStart {
+					spelled = "a"
+					if lastVowel != "" {
+						spelled = ""
+					}
+				}
+			}
+		}
+
+		if s.points == nil && u.r == 'י' && isLetter(i+1) &&
+			(strings.ContainsRune("אוײױע", units[i+1].r) || units[i+1].r == 'י' && units[i+1].vowel == 'ִ') {
+			// Yiddish yud before a vowel letter is a consonant
+			spelled = "y"
+		}
+
+		if wordEnd && vowel == "a" && strings.ContainsRune("חעה", u.r) {
+			// Furtive patah is read before its letter
+			b.WriteString(vowel)
+			vowel = ""
+		}
+		b.WriteString(spelled)
+		b.WriteString(vowel)
+		switch {
+		case vowel != "":
+			lastVowel = vowel
+		case spelled == "o" || spelled == "u" || spelled == "i":
+			lastVowel = spelled
+		default:
+			lastVowel = ""
+		}
+	}
+}
+
+// Devanagari tables shared by Hindi, Marathi and Nepali.
+var (
+	devanagariVowels = map[rune]string{
+		'अ': "a", 'आ': "a", 'इ': "i", 'ई': "i", 'उ': "u", 'ऊ': "u", 'ऋ': "ri", 'ॠ': "ri",
+		'ऌ': "li", 'ए': "e", 'ऐ': "ai", 'ओ': "o", 'औ': "au", 'ऑ': "o", 'ऍ': "e",
+	}
+	devanagariMatras = map[rune]string{
+		'ा': "a", 'ि': "i", 'ी': "i", 'ु': "u", 'ू': "u", 'ृ': "ri", 'ॄ': "ri",
+		'े': "e", 'ै': "ai", 'ो': "o", 'ौ': "au", 'ॉ': "o", 'ॅ': "e",
+	}
+	devanagariConsonants = map[rune]string{
+		'क': "k", 'ख': "kh", 'ग': "g", 'घ': "gh", 'ङ': "n",
+		'च': "ch", 'छ': "chh", 'ज': "j", 'झ': "jh", 'ञ': "n",
+		'ट': "t", 'ठ': "th", 'ड': "d", 'ढ': "dh", 'ण': "n",
+		'त': "t", 'थ': "th", 'द': "d", 'ध': "dh", 'न': "n",
+		'प': "p", 'फ': "ph", 'ब': "b", 'भ': "bh", 'म': "m",
+		'य': "y", 'र': "r", 'ल': "l", 'ळ': "l", 'व': "v",
+		'श': "sh", 'ष': "sh", 'स': "s", 'ह': "h",
+		// Precomposed nukta letters
+		'\u0958': "q", '\u0959': "kh", '\u095a': "gh", '\u095b': "z",
+		'\u095c': "r", '\u095d': "rh", '\u095e': "f", '\u095f': "y",
+	}
+	// devanagariNukta gives the sound of a consonant followed by a combining nukta.
+	devanagariNukta = map[rune]string{
+		'क': "q", 'ख': "kh", 'ग': "gh", 'ज': "z", 'ड': "r", 'ढ': "rh", 'फ': "f", 'य': "y",
+	}
+)
+
+// TransliterateDevanagari converts Devanagari text (Hindi, Marathi, Nepali)
+// to Latin. Consonants carry an inherent "a" unless followed by a matra or a
+// virama; the inherent vowel is dropped at the end of a word, except after a
+// conjunct or in a single-letter word. Anusvara becomes "m" before labials
+// and "n" elsewhere.
+func TransliterateDevanagari(input string, b *strings.Builder) {
+	const (
+		virama = '्'
+		nukta  = '़'
+	)
+	runes := []rune(input)
+	inWord := func(i int) bool {
+		return i < len(runes) && (unicode.IsLetter(runes[i]) || unicode.IsMark(runes[i]))
+	}
+
+	syllables := 0 // aksharas written in the current word
+	cluster := false
+	for i := 0; i < len(runes); {
+		r := runes[i]
+		if i == 0 || !inWord(i-1) {
+			syllables = 0
+			cluster = false
+		}
+
+		if latin, ok := devanagariConsonants[r]; ok {
+			j := i + 1
+			if j < len(runes) && runes[j] == nukta {
+				if sound, ok := devanagariNukta[r]; ok {
+					latin = sound
+				}
+				j++
+			}
+			// ज्ञ is read as "gy"
+			if r == 'ज' && j+1 < len(runes) && runes[j] == virama && runes[j+1] == 'ञ' {
+				latin = "gy"
+				j += 2
+				cluster = true
+			}
+			b.WriteString(latin)
+
+			switch {
+			case j < len(runes) && runes[j] == virama:
+				cluster = true
+				j++
+			case j < len(runes) && devanagariMatras[runes[j]] != "":
+				b.WriteString(devanagariMatras[runes[j]])
+				syllables++
+				cluster = false
+				j++
+			default:
+				// Schwa deletion at the end of a word
+				if inWord(j) || syllables == 0 || cluster {
+					b.WriteByte('a')
+				}
+				syllables++
+				cluster = false
+			}
+			i = j
+			continue
+		}
+
+		switch {
+		case devanagariVowels[r] != "":
+			b.WriteString(devanagariVowels[r])
+			syllables++
+		case r == 'ं' || r == 'ँ':
+			if i+1 < len(runes) && strings.ContainsRune("पफबभम", runes[i+1]) {
+				b.WriteByte('m')
+			} else {
+				b.WriteByte('n')
+			}
+		case r == 'ः':
+			b.WriteByte('h')
+		case r >= '०' && r <= '९':
+			b.WriteRune('0' + r - '०')
+		case r == '।' || r == '॥':
+			b.WriteByte(' ')
+		case r == 'ऽ' || r == virama || r == nukta || devanagariMatras[r] != "":
+			// Stray signs without a consonant to attach to
+		default:
+			if !unicode.Is(unicode.Devanagari, r) {
+				b.WriteRune(r)
+			}
+		}
+		i++
+	}
+}
+
+// TransliterateChinese converts Simplified and Traditional Chinese to toneless
+// Pinyin, one space-separated syllable per character. Common words whose
+// characters have several readings are looked up first, longest match wins.
+func TransliterateChinese(input string, b *strings.Builder) {
+	runes := []rune(input)
+	for i := 0; i < len(runes); {
+		// Polyphone word overrides
+		matched := false
+		for n := min(pinyinMaxWord, len(runes)-i); n >= 2; n-- {
+			if reading, ok := pinyinWords[string(runes[i:i+n])]; ok {
+				b.WriteByte(' ')
+				b.WriteString(reading)
+				b.WriteByte(' ')
+				i += n
+				matched = true
+				break
+			}
+		}
+		if matched {
+			continue
+		}
+
+		r := runes[i]
+		switch reading := pinyinRune(r); {
+		case reading != "":
+			b.WriteByte(' ')
+			b.WriteString(reading)
+			b.WriteByte(' ')
+		case unicode.Is(unicode.Han, r), unicode.In(r, unicode.P) && r > unicode.MaxASCII:
+			// Unknown characters and full-width punctuation
+			b.WriteByte(' ')
+		default:
+			b.WriteRune(r)
+		}
+		i++
+	}
+}
+
+// KanjiReader supplies readings for kanji, which cannot be romanized from the
+// characters alone. Plug one in with WithKanjiReader.
+type KanjiReader interface {
+	// ReadKanji returns the reading, in kana or romaji, of the longest prefix
+	// of text it knows and the number of bytes of text that prefix spans.
+	// A size of 0 means no reading was found.
+	ReadKanji(text string) (reading string, size int)
+}
+
+// KanjiDict is a KanjiReader backed by a word to reading map, matched
+// longest-first.
+type KanjiDict map[string]string
+
+// ReadKanji returns the reading of the longest word in d that prefixes text.
+func (d KanjiDict) ReadKanji(text string) (string, int) {
+	best := 0
+	for word := range d {
+		if len(word) > best && strings.HasPrefix(text, word) {
+			best = len(word)
+		}
+	}
+	if best == 0 {
+		return "", 0
+	}
+	return d[text[:best]], best
+}
+
+// hiraganaToRomaji holds the Hepburn spelling of hiragana and of the small
+// vowel combinations used in katakana loanwords. Katakana is folded to
+// hiragana before lookup.
+var hiraganaToRomaji = map[string]string{
+	"あ": "a", "い": "i", "う": "u", "え": "e", "お": "o",
+	"か": "ka", "き": "ki", "く": "ku", "け": "ke", "こ": "ko",
+	"さ": "sa", "し": "shi", "す": "su", "せ": "se", "そ": "so",
+	"た": "ta", "ち": "chi", "つ": "tsu", "て": "te", "と": "to",
+	"な": "na", "に": "ni", "ぬ": "nu", "ね": "ne", "の": "no",
+	"は": "ha", "ひ": "hi", "ふ": "fu", "へ": "he", "ほ": "ho",
+	"ま": "ma", "み": "mi", "む": "mu", "め": "me", "も": "mo",
+	"や": "ya", "ゆ": "yu", "よ": "yo",
+	"ら": "ra", "り": "ri", "る": "ru", "れ": "re", "ろ": "ro",
+	"わ": "wa", "ゐ": "i", "ゑ": "e", "を": "o", "ん": "n",
+	"が": "ga", "ぎ": "gi", "ぐ": "gu", "げ": "ge", "ご": "go",
+	"ざ": "za", "じ": "ji", "ず": "zu", "ぜ": "ze", "ぞ": "zo",
+	"だ": "da", "ぢ": "ji", "づ": "zu", "で": "de", "ど": "do",
+	"ば": "ba", "び": "bi", "ぶ": "bu", "べ": "be", "ぼ": "bo",
+	"ぱ": "pa", "ぴ": "pi", "ぷ": "pu", "ぺ": "pe", "ぽ": "po",
+	"ゔ": "vu", "ぁ": "a", "ぃ": "i", "ぅ": "u", "ぇ": "e", "ぉ": "o",
+	"ゃ": "ya", "ゅ": "yu", "ょ": "yo", "ゎ": "wa",
+
+	// Yōon
+	"きゃ": "kya", "きゅ": "kyu", "きょ": "kyo", "しゃ": "sha", "しゅ": "shu", "しょ": "sho",
+	"ちゃ": "cha", "ちゅ": "chu", "ちょ": "cho", "にゃ": "nya", "にゅ": "nyu", "にょ": "nyo",
+	"ひゃ": "hya", "ひゅ": "hyu", "ひょ": "hyo", "みゃ": "mya", "みゅ": "myu", "みょ": "myo",
+	"りゃ": "rya", "りゅ": "ryu", "りょ": "ryo", "ぎゃ": "gya", "ぎゅ": "gyu", "ぎょ": "gyo",
+	"じゃ": "ja", "じゅ": "ju", "じょ": "jo", "ぢゃ": "ja", "ぢゅ": "ju", "ぢょ": "jo",
+	"びゃ": "bya", "びゅ": "byu", "びょ": "byo", "ぴゃ": "pya", "ぴゅ": "pyu", "ぴょ": "pyo",
+
+	// Loanword combinations
+	"しぇ": "she", "じぇ": "je", "ちぇ": "che", "つぁ": "tsa", "つぃ": "tsi", "つぇ": "tse",
+	"つぉ": "tso", "てぃ": "ti", "でぃ": "di", "とぅ": "tu", "どぅ": "du", "ふぁ": "fa",
+	"ふぃ": "fi", "ふぇ": "fe", "ふぉ": "fo", "うぃ": "wi", "うぇ": "we", "うぉ": "wo",
+	"ゔぁ": "va", "ゔぃ": "vi", "ゔぇ": "ve", "ゔぉ": "vo", "いぇ": "ye", "てゅ": "tyu",
+	"でゅ": "dyu", "ふゅ": "fyu",
+}
+
+// japaneseScript classifies r for word breaking: 'k' for kanji, 'h' for
+// hiragana, 'K' for katakana and 0 for anything else.
+func japaneseScript(r rune) byte {
+	switch {
+	case unicode.Is(unicode.Han, r) || r == '々':
+		return 'k'
+	case unicode.Is(unicode.Hiragana, r):
+		return 'h'
+	case unicode.Is(unicode.Katakana, r) || r == 'ー':
+		return 'K'
+	}
+	return 0
+}
+
+// TransliterateJapanese romanizes hiragana and katakana using Hepburn rules.
+// Kanji are left as-is; see WithKanjiReader to supply their readings.
+func TransliterateJapanese(input string, b *strings.Builder) {
+	transliterateJapanese(input, b, nil)
+}
+
+// transliterateJapanese romanizes kana with sokuon doubling, long vowels
+// shortened as in passport Hepburn (とうきょう -> tokyo), and ん written n'
+// before vowels and y. Words are broken before kanji and around katakana
+// runs, and kanji are read through reader when one is given.
+func transliterateJapanese(input string, b *strings.Builder, reader KanjiReader) {
+	// Fold katakana to hiragana so both share one table
+	runes := []rune(input)
+	kana := make([]rune, len(runes))
+	for i, r := range runes {
+		if r >= 'ァ' && r <= 'ヶ' {
+			r -= 'ァ' - 'ぁ'
+		}
+		kana[i] = r
+	}
+
+	sokuon := false
+	var lastVowel byte
+	var prevScript byte
+	for i := 0; i < len(kana); {
+		r := kana[i]
+		script := japaneseScript(runes[i])
+		if script != prevScript && (script == 'k' && prevScript != 0 || script == 'K' || prevScript == 'K') {
+			b.WriteByte(' ')
+			sokuon, lastVowel = false, 0
+		}
+		prevScript = script
+
+		if script == 'k' && reader != nil {
+			if reading, size := reader.ReadKanji(string(runes[i:])); size > 0 {
+				transliterateJapanese(reading, b, nil)
+				i += utf8.RuneCountInString(string(runes[i:])[:size])
+				sokuon, lastVowel = false, 0
+				continue
+			}
+		}
+
+		switch r {
+		case 'っ':
+			sokuon = true
+			i++
+			continue
+		case 'ー':
+			// Long vowel mark
+			i++
+			continue
+		}
+
+		syllable := ""
+		if i+1 < len(kana) {
+			if mapped, ok := hiraganaToRomaji[string(kana[i:i+2])]; ok {
+				syllable = mapped
+				i += 2
+			}
+		}
+		if syllable == "" {
+			if mapped, ok := hiraganaToRomaji[string(r)]; ok {
+				syllable = mapped
+				i++
+			}
+		}
+
+		if syllable == "" {
+			sokuon, lastVowel = false, 0
+			switch {
+			case r >= '！' && r <= '～':
+				// Full-width ASCII
+				b.WriteRune(r - '！' + '!')
+			case unicode.IsPunct(r) || unicode.IsSpace(r) || r == '・':
+				b.WriteByte(' ')
+			default:
+				b.WriteRune(r)
+			}
+			i++
+			continue
+		}
+
+		// Long vowels: ou, oo and uu are written with a single vowel
+		if (syllable == "u" && (lastVowel == 'o' || lastVowel == 'u')) || (syllable == "o" && lastVowel == 'o' && r == 'お') {
+			lastVowel = 0
+			continue
+		}
+		if syllable == "n" && i < len(kana) && strings.ContainsRune("あいうえおやゆよ", kana[i]) {
+			syllable = "n'"
+		}
+		if sokuon {
+			if strings.HasPrefix(syllable, "ch") {
+				b.WriteByte('t')
+			} else if !strings.ContainsRune("aeiou", rune(syllable[0])) {
+				b.WriteByte(syllable[0])
+			}
+			sokuon = false
+		}
+		b.WriteString(syllable)
+		lastVowel = syllable[len(syllable)-1]
+	}
+}
+
+// Revised Romanization of the jamo in a Hangul syllable block, indexed by
+// their position in the Unicode decomposition.
+var (
+	hangulInitials = [19]string{"g", "kk", "n", "d", "tt", "r", "m", "b", "pp", "s", "ss", "", "j", "jj", "ch", "k", "t", "p", "h"}
+	hangulVowels   = [21]string{"a", "ae", "ya", "yae", "eo", "e", "yeo", "ye", "o", "wa", "wae", "oe", "yo", "u", "wo", "we", "wi", "yu", "eu", "ui", "i"}
+	// hangulFinals holds the sound of each final consonant when no vowel follows.
+	hangulFinals = [28]string{"", "k", "k", "k", "n", "n", "n", "t", "l", "k", "m", "l", "l", "l", "p", "l", "m", "p", "p", "t", "t", "ng", "t", "t", "k", "t", "p", "t"}
+	// hangulLiaison splits each final into the part that stays and the part
+	// that moves onto a following syllable starting with a silent ㅇ.
+	hangulLiaison = [28][2]string{
+		{"", ""}, {"", "g"}, {"", "kk"}, {"k", "s"}, {"", "n"}, {"n", "j"}, {"", "n"},
+		{"", "d"}, {"", "r"}, {"l", "g"}, {"l", "m"}, {"l", "b"}, {"l", "s"}, {"l", "t"},
+		{"l", "p"}, {"", "r"}, {"", "m"}, {"", "b"}, {"p", "s"}, {"", "s"}, {"", "ss"},
+		{"ng", ""}, {"", "j"}, {"", "ch"}, {"", "k"}, {"", "t"}, {"", "p"}, {"", ""},
+	}
+)
+
+// Jamo indices used by the assimilation rules.
+const (
+	hangulInitialG    = 0
+	hangulInitialN    = 2
+	hangulInitialD    = 3
+	hangulInitialR    = 5
+	hangulInitialM    = 6
+	hangulInitialNone = 11
+	hangulInitialJ    = 12
+	hangulInitialH    = 18
+	hangulVowelI      = 20
+	hangulFinalNH     = 6
+	hangulFinalD      = 7
+	hangulFinalLT     = 13
+	hangulFinalLH     = 15
+	hangulFinalT      = 25
+	hangulFinalH      = 27
+)
+
+// TransliterateKorean romanizes Hangul following the Revised Romanization of
+// Korean. Syllable blocks are decomposed algorithmically and the basic sound
+// changes across syllable boundaries are applied: liaison before a silent ㅇ,
+// palatalization of ㄷ and ㅌ before 이 and 히, nasalization before ㄴ and ㅁ,
+// lateralization and ㄹ nasalization, and aspiration after ㅎ.
+func TransliterateKorean(input string, b *strings.Builder) {
+	const (
+		base  = 0xAC00
+		last  = 0xD7A3
+		perL  = 21 * 28
+		count = 28
+	)
+	runes := []rune(input)
+	isSyllable := func(i int) bool {
+		return i < len(runes) && runes[i] >= base && runes[i] <= last
+	}
+
+	// carried is the initial of the current syllable as changed by the
+	// previous syllable's final.
+	carried := ""
+	hasCarried := false
+	for i, r := range runes {
+		if !isSyllable(i) {
+			hasCarried = false
+			b.WriteRune(r)
+			continue
+		}
+		idx := int(r - base)
+		l, v, t := idx/perL, (idx%perL)/count, idx%count
+
+		if hasCarried {
+			b.WriteString(carried)
+		} else {
+			b.WriteString(hangulInitials[l])
+		}
+		b.WriteString(hangulVowels[v])
+		hasCarried = false
+
+		if !isSyllable(i + 1) {
+			b.WriteString(hangulFinals[t])
+			continue
+		}
+		next := int(runes[i+1]-base) / perL
+		nextVowel := (int(runes[i+1]-base) % perL) / count
+		final, initial := hangulFinals[t], hangulInitials[next]
+		switch {
+		case t == 0:
+		case nextVowel == hangulVowelI && next == hangulInitialNone &&
+			(t == hangulFinalD || t == hangulFinalT || t == hangulFinalLT):
+			// ㄷ and ㅌ palatalize before 이: 굳이 guji, 같이 gachi
+			switch t {
+			case hangulFinalD:
+				final, initial = "", "j"
+			case hangulFinalT:
+				final, initial = "", "ch"
+			case hangulFinalLT:
+				final, initial = "l", "ch"
+			}
+		case nextVowel == hangulVowelI && next == hangulInitialH && t == hangulFinalD:
+			// 굳히다 guchida
+			final, initial = "", "ch"
+		case next == hangulInitialNone:
+			final, initial = hangulLiaison[t][0], hangulLiaison[t][1]
+		case (t == hangulFinalH || t == hangulFinalNH || t == hangulFinalLH) &&
+			(next == hangulInitialG || next == hangulInitialD || next == hangulInitialJ):
+			// ㅎ aspirates the following consonant
+			switch t {
+			case hangulFinalNH:
+				final = "n"
+			case hangulFinalLH:
+				final = "l"
+			default:
+				final = ""
+			}
+			switch next {
+			case hangulInitialG:
+				initial = "k"
+			case hangulInitialD:
+				initial = "t"
+			case hangulInitialJ:
+				initial = "ch"
+			}
+		case next == hangulInitialN || next == hangulInitialM:
+			switch final {
+			case "k":
+				final = "ng"
+			case "t":
+				final = "n"
+			case "p":
+				final = "m"
+			case "l":
+				if next == hangulInitialN {
+					initial = "l"
+				}
+			}
+		case next == hangulInitialR:
+			switch final {
+			case "n", "l":
+				final, initial = "l", "l"
+			case "m", "ng":
+				initial = "n"
+			case "k":
+				final, initial = "ng", "n"
+			case "p":
+				final, initial = "m", "n"
+			case "t":
+				final, initial = "n", "n"
+			}
+		}
+		b.WriteString(final)
+		carried, hasCarried = initial, true
+	}
+}
+
+// cyrillicTable describes the romanization of one Cyrillic alphabet. Keys are
+// lowercase.
+type cyrillicTable struct {
+	letters map[rune]string   // Default spelling of each letter
+	initial map[rune]string   // Spelling at the start of a word, where it differs
+	pairs   map[string]string // Two-letter combinations spelled as a unit
+	finals  map[string]string // Two-letter combinations at the end of a word
+
+	// initialAfter lists letters after which the initial spelling is used
+	// too, as with BGN/PCGN ye after vowels.
+	initialAfter string
+
+	// fold removes the diacritics the standard writes, leaving ASCII
+	fold bool
+}
+
+// russianSchemes holds the supported Russian romanizations. Apostrophes and
+// quote marks the standards use for ъ, ь, ы and э are left out so they do not
+// split words in slugs.
+var russianSchemes = map[string]*cyrillicTable{
+	// The common informal spelling
+	"simple": {
+		letters: map[rune]string{
+			'а': "a", 'б': "b", 'в': "v", 'г': "g", 'д': "d", 'е': "e", 'ё': "yo", 'ж': "zh",
+			'з': "z", 'и': "i", 'й': "y", 'к': "k", 'л': "l", 'м': "m", 'н': "n", 'о': "o",
+			'п': "p", 'р': "r", 'с': "s", 'т': "t", 'у': "u", 'ф': "f", 'х': "kh", 'ц': "ts",
+			'ч': "ch", 'ш': "sh", 'щ': "shch", 'ъ': "", 'ы': "y", 'ь': "", 'э': "e", 'ю': "yu",
+			'я': "ya",
+		},
+	},
+	// The informal "translit" used in URLs and chat
+	"translit": {
+		letters: map[rune]string{
+			'а': "a", 'б': "b", 'в': "v", 'г': "g", 'д': "d", 'е': "e", 'ё': "yo", 'ж': "zh",
+			'з': "z", 'и': "i", 'й': "j", 'к': "k", 'л': "l", 'м': "m", 'н': "n", 'о': "o",
+			'п': "p", 'р': "r", 'с': "s", 'т': "t", 'у': "u", 'ф': "f", 'х': "h", 'ц': "c",
+			'ч': "ch", 'ш': "sh", 'щ': "sch", 'ъ': "", 'ы': "y", 'ь': "", 'э': "e", 'ю': "yu",
+			'я': "ya",
+		},
+	},
+	// GOST 7.79-2000 System B, with ц written c before е, и, ы and й
+	"gost": {
+		letters: map[rune]string{
+			'а': "a", 'б': "b", 'в': "v", 'г': "g", 'д': "d", 'е': "e", 'ё': "yo", 'ж': "zh",
+			'з': "z", 'и': "i", 'й': "j", 'к': "k", 'л': "l", 'м': "m", 'н': "n", 'о': "o",
+			'п': "p", 'р': "r", 'с': "s", 'т': "t", 'у': "u", 'ф': "f", 'х': "x", 'ц': "cz",
+			'ч': "ch", 'ш': "sh", 'щ': "shh", 'ъ': "", 'ы': "y", 'ь': "", 'э': "e", 'ю': "yu",
+			'я': "ya",
+		},
+		pairs: map[string]string{"це": "ce", "ци": "ci", "цы": "cy", "цй": "cj"},
+	},
+	// ISO 9:1995 (GOST 7.79 System A), one Latin letter per Cyrillic letter,
+	// with its diacritics folded to ASCII (ž -> z, ŝ -> s)
+	"iso9": {
+		letters: map[rune]string{
+			'а': "a", 'б': "b", 'в': "v", 'г': "g", 'д': "d", 'е': "e", 'ё': "ë", 'ж': "ž",
+			'з': "z", 'и': "i", 'й': "j", 'к': "k", 'л': "l", 'м': "m", 'н': "n", 'о': "o",
+			'п': "p", 'р': "r", 'с': "s", 'т': "t", 'у': "u", 'ф': "f", 'х': "h", 'ц': "c",
+			'ч': "č", 'ш': "š", 'щ': "ŝ", 'ъ': "", 'ы': "y", 'ь': "", 'э': "è", 'ю': "û",
+			'я': "â",
+		},
+		fold: true,
+	},
+	// BGN/PCGN 1947, with ë written e
+	"bgn": {
+		letters: map[rune]string{
+			'а': "a", 'б': "b", 'в': "v", 'г': "g", 'д': "d", 'е': "e", 'ё': "e", 'ж': "zh",
+			'з': "z", 'и': "i", 'й': "y", 'к': "k", 'л': "l", 'м': "m", 'н': "n", 'о': "o",
+			'п': "p", 'р': "r", 'с': "s", 'т': "t", 'у': "u", 'ф': "f", 'х': "kh", 'ц': "ts",
+			'ч': "ch", 'ш': "sh", 'щ': "shch", 'ъ': "", 'ы': "y", 'ь': "", 'э': "e", 'ю': "yu",
+			'я': "ya",
+		},
+		initial:      map[rune]string{'е': "ye", 'ё': "ye"},
+		initialAfter: "аеёиоуыэюяйъь",
+	},
+}
+
+// Cyrillic alphabets other than Russian, each following its national
+// romanization with diacritics folded to ASCII where the standard uses them.
+var cyrillicTables = map[string]*cyrillicTable{
+	// Ukrainian: Cabinet of Ministers resolution No. 55 (2010)
+	"uk": {
+		letters: map[rune]string{
+			'а': "a", 'б': "b", 'в': "v", 'г': "h", 'ґ': "g", 'д': "d", 'е': "e", 'є': "ie",
+			'ж': "zh", 'з': "z", 'и': "y", 'і': "i", 'ї': "i", 'й': "i", 'к': "k", 'л': "l",
+			'м': "m", 'н': "n", 'о': "o", 'п': "p", 'р': "r", 'с': "s", 'т': "t", 'у': "u",
+			'ф': "f", 'х': "kh", 'ц': "ts", 'ч': "ch", 'ш': "sh", 'щ': "shch", 'ь': "",
+			'ю': "iu", 'я': "ia", '\'': "", '’': "", 'ʼ': "",
+		},
+		initial: map[rune]string{'є': "ye", 'ї': "yi", 'й': "y", 'ю': "yu", 'я': "ya"},
+		pairs:   map[string]string{"зг": "zgh"},
+	},
+	// Belarusian: 2007 national system (č, š, ž, ŭ written ch, sh, zh, w)
+	"be": {
+		letters: map[rune]string{
+			'а': "a", 'б': "b", 'в': "v", 'г': "h", 'ґ': "g", 'д': "d", 'е': "ie", 'ё': "io",
+			'ж': "zh", 'з': "z", 'і': "i", 'й': "j", 'к': "k", 'л': "l", 'м': "m", 'н': "n",
+			'о': "o", 'п': "p", 'р': "r", 'с': "s", 'т': "t", 'у': "u", 'ў': "w", 'ф': "f",
+			'х': "kh", 'ц': "ts", 'ч': "ch", 'ш': "sh", 'ы': "y", 'ь': "", 'э': "e",
+			'ю': "iu", 'я': "ia", '\'': "", '’': "", 'ʼ': "",
+		},
+		initial: map[rune]string{'е': "ye", 'ё': "yo", 'ю': "yu", 'я': "ya"},
+	},
+	// Serbian: the official Latin alphabet with diacritics folded (đ -> dj)
+	"sr": {
+		letters: map[rune]string{
+			'а': "a", 'б': "b", 'в': "v", 'г': "g", 'д': "d", 'ђ': "dj", 'е': "e", 'ж': "z",
+			'з': "z", 'и': "i", 'ј': "j", 'к': "k", 'л': "l", 'љ': "lj", 'м': "m", 'н': "n",
+			'њ': "nj", 'о': "o", 'п': "p", 'р': "r", 'с': "s", 'т': "t", 'ћ': "c", 'у': "u",
+			'ф': "f", 'х': "h", 'ц': "c", 'ч': "c", 'џ': "dz", 'ш': "s",
+		},
+	},
+	// Bulgarian: Streamlined System (2009), with word-final -ия as -ia
+	"bg": {
+		letters: map[rune]string{
+			'а': "a", 'б': "b", 'в': "v", 'г': "g", 'д': "d", 'е': "e", 'ж': "zh", 'з': "z",
+			'и': "i", 'й': "y", 'к': "k", 'л': "l", 'м': "m", 'н': "n", 'о': "o", 'п': "p",
+			'р': "r", 'с': "s", 'т': "t", 'у': "u", 'ф': "f", 'х': "h", 'ц': "ts", 'ч': "ch",
+			'ш': "sh", 'щ': "sht", 'ъ': "a", 'ь': "y", 'ю': "yu", 'я': "ya",
+		},
+		finals: map[string]string{"ия": "ia"},
+	},
+	// Kazakh: 2021 Latin alphabet with diacritics folded (ş, ç written sh, ch)
+	"kk": {
+		letters: map[rune]string{
+			'а': "a", 'ә': "a", 'б': "b", 'в': "v", 'г': "g", 'ғ': "g", 'д': "d", 'е': "e",
+			'ё': "io", 'ж': "j", 'з': "z", 'и': "i", 'й': "i", 'к': "k", 'қ': "q", 'л': "l",
+			'м': "m", 'н': "n", 'ң': "n", 'о': "o", 'ө': "o", 'п': "p", 'р': "r", 'с': "s",
+			'т': "t", 'у': "u", 'ұ': "u", 'ү': "u", 'ф': "f", 'х': "h", 'һ': "h", 'ц': "ts",
+			'ч': "ch", 'ш': "sh", 'щ': "shch", 'ъ': "", 'ы': "y", 'і': "i", 'ь': "", 'э': "e",
+			'ю': "iu", 'я': "ia",
+		},
+	},
+}
+
+// transliterateCyrillic romanizes input with table. Capital letters come out
+// capitalized, or fully uppercase inside all-caps words, so the case survives
+// pipelines that do not lowercase. Letters missing from the table are kept.
+func transliterateCyrillic(input string, b *strings.Builder, table *cyrillicTable) {
+	runes := []rune(input)
+	write := func(mapped string, i, n int) {
+		if table.fold {
+			mapped = removeMarks(mapped)
+		}
+		writeCased(b, mapped, runes, i, n)
+	}
+	// Apostrophes sit inside Ukrainian and Belarusian words
+	inWord := func(i int) bool {
+		if i < 0 || i >= len(runes) {
+			return false
+		}
+		r := runes[i]
+		return unicode.IsLetter(r) || r == '\'' || r == '’' || r == 'ʼ'
+	}
+	initial := func(i int) bool {
+		return !inWord(i-1) || strings.ContainsRune(table.initialAfter, unicode.ToLower(runes[i-1]))
+	}
+
+	for i := 0; i < len(runes); {
+		r := unicode.ToLower(runes[i])
 		if i+1 < len(runes) {
-			pair := string(runes[i : i+2])
-			if mapped, ok := bengaliToBanglish[pair]; ok {
-				b.WriteString(mapped)
+			pair := string([]rune{r, unicode.ToLower(runes[i+1])})
+			if mapped, ok := table.finals[pair]; ok && !inWord(i+2) {
+				write(mapped, i, 2)
+				i += 2
+				continue
+			}
+			if mapped, ok := table.pairs[pair]; ok {
+				write(mapped, i, 2)
 				i += 2
 				continue
 			}
 		}
 
-		// Single rune
-		current := string(runes[i])
-		if mapped, ok := bengaliToBanglish[current]; ok {
-			b.WriteString(mapped)
+		if mapped, ok := table.initial[r]; ok && initial(i) {
+			write(mapped, i, 1)
+		} else if mapped, ok := table.letters[r]; ok {
+			write(mapped, i, 1)
 		} else {
 			b.WriteRune(runes[i])
 		}
 		i++
 	}
+}
 
-	return b.String()
+// writeCased writes mapped, the romanization of the n runes at runes[i], in
+// the case of the source: lowercase, capitalized, or uppercase when a
+// neighbouring letter is uppercase too.
+func writeCased(b *strings.Builder, mapped string, runes []rune, i, n int) {
+	if mapped == "" || !unicode.IsUpper(runes[i]) {
+		b.WriteString(mapped)
+		return
+	}
+
+	next := i + n
+	nextUpper := next < len(runes) && unicode.IsUpper(runes[next])
+	nextLower := next < len(runes) && unicode.IsLower(runes[next])
+	prevUpper := i > 0 && unicode.IsUpper(runes[i-1])
+	if nextUpper || (prevUpper && !nextLower) {
+		b.WriteString(strings.ToUpper(mapped))
+		return
+	}
+
+	first, size := utf8.DecodeRuneInString(mapped)
+	b.WriteRune(unicode.ToUpper(first))
+	b.WriteString(mapped[size:])
 }
 
-// transliterateCyrillic handles Cyrillic script (Russian).
-func TransliterateRussian(input string, b *strings.Builder) {
-	for _, r := range input {
-		switch r {
-		case 'а':
-			b.WriteString("a")
-		case 'б':
-			b.WriteString("b")
-		case 'в':
-			b.WriteString("v")
-		case 'г':
-			b.WriteString("g")
-		case 'д':
-			b.WriteString("d")
-		case 'е':
-			b.WriteString("e")
-		case 'ё':
-			b.WriteString("yo")
-		case 'ж':
-			b.WriteString("zh")
-		case 'з':
-			b.WriteString("z")
-		case 'и':
-			b.WriteString("i")
-		case 'й':
-			b.WriteString("y")
-		case 'к':
-			b.WriteString("k")
-		case 'л':
-			b.WriteString("l")
-		case 'м':
-			b.WriteString("m")
-		case 'н':
-			b.WriteString("n")
-		case 'о':
-			b.WriteString("o")
-		case 'п':
-			b.WriteString("p")
-		case 'р':
-			b.WriteString("r")
-		case 'с':
-			b.WriteString("s")
-		case 'т':
-			b.WriteString("t")
-		case 'у':
-			b.WriteString("u")
-		case 'ф':
-			b.WriteString("f")
-		case 'х':
-			b.WriteString("kh")
-		case 'ц':
-			b.WriteString("ts")
-		case 'ч':
-			b.WriteString("ch")
-		case 'ш':
-			b.WriteString("sh")
-		case 'щ':
-			b.WriteString("shch")
-		case 'ъ':
-			b.WriteString("")
-		case 'ы':
-			b.WriteString("y")
-		case 'ь':
-			b.WriteString("")
-		case 'э':
-			b.WriteString("e")
-		case 'ю':
-			b.WriteString("yu")
-		case 'я':
-			b.WriteString("ya")
-		case ' ':
+// latinFolds spells the Latin letters that have no decomposition, so
+// diacritic removal would drop them. latinTables holds the spellings each
+// language prefers over plain removal, checked first.
+var (
+	latinFolds = map[rune]string{
+		'ß': "ss", 'æ': "ae", 'œ': "oe", 'ø': "o", 'ł': "l", 'đ': "d", 'ð': "d", 'þ': "th",
+		'ı': "i", 'ħ': "h", 'ŧ': "t", 'ŀ': "l", 'ĳ': "ij", 'ŋ': "ng", 'ſ': "s", 'ə': "e",
+	}
+	latinTables = map[string]map[rune]string{
+		"de": {'ä': "ae", 'ö': "oe", 'ü': "ue"},
+		"da": {'æ': "ae", 'ø': "oe", 'å': "aa"},
+		"no": {'æ': "ae", 'ø': "oe", 'å': "aa"},
+		"nb": {'æ': "ae", 'ø': "oe", 'å': "aa"},
+		"nn": {'æ': "ae", 'ø': "oe", 'å': "aa"},
+		"sv": nil, "fi": nil, "is": nil, "et": nil,
+		"fr": nil, "es": nil, "pt": nil, "it": nil,
+		"ca": {'·': ""}, // l·l
+		"nl": nil,
+		"tr": nil, "az": nil,
+		"pl": nil, "cs": nil, "sk": nil, "sl": nil, "hu": nil, "ro": nil,
+		"hr": {'đ': "dj"}, "bs": {'đ': "dj"},
+		"lv": nil, "lt": nil, "mt": nil,
+		"vi": nil,
+	}
+)
+
+// transliterateLatin folds Latin letters to ASCII, first with the language's
+// own spellings (Größe -> Groesse in German) and then by removing diacritics.
+// Case is kept as in transliterateCyrillic; other scripts pass through.
+func transliterateLatin(input string, b *strings.Builder, table map[rune]string) {
+	folded := new(strings.Builder)
+
+	text := []rune(norm.NFC.String(input))
+	for i, r := range text {
+		lower := unicode.ToLower(r)
+		if mapped, ok := table[lower]; ok {
+			writeCased(folded, mapped, text, i, 1)
+		} else if mapped, ok := latinFolds[lower]; ok {
+			writeCased(folded, mapped, text, i, 1)
+		} else {
+			folded.WriteRune(r)
+		}
+	}
+
+	b.WriteString(removeMarks(folded.String()))
+}
+
+// removeMarks strips the diacritics from s by decomposing it and dropping
+// the combining marks.
+func removeMarks(s string) string {
+	if isASCII(s) {
+		return s
+	}
+	t := transform.Chain(norm.NFD, runes.Remove(runes.In(unicode.Mn)))
+	result, _, _ := transform.String(t, s)
+	return result
+}
+
+// spacelessScript describes a script written without spaces between words,
+// for transliterateSpaceless.
+type spacelessScript struct {
+	script   *unicode.RangeTable
+	words    *wordlist
+	cluster  func(runes []rune, i int) int         // Length of the character cluster at i
+	romanize func(word []rune, b *strings.Builder) // Letter rules for words without a listed romanization
+	zero     rune                                  // The script's digit zero
+	repeat   rune                                  // Mark repeating the previous word, if any
+}
+
+// transliterateSpaceless splits the runs of input written in s into words
+// with its wordlist and romanizes them one by one, separated by spaces.
+func transliterateSpaceless(input string, b *strings.Builder, s *spacelessScript) {
+	runes := []rune(input)
+	last := ""
+	for i := 0; i < len(runes); {
+		r := runes[i]
+		switch {
+		case !unicode.Is(s.script, r):
+			b.WriteRune(r)
+			i++
+		case r >= s.zero && r <= s.zero+9:
+			b.WriteByte(byte('0' + r - s.zero))
+			i++
+		case r == s.repeat:
+			b.WriteByte(' ')
+			b.WriteString(last)
 			b.WriteByte(' ')
+			i++
+		case !unicode.IsLetter(r) && !unicode.IsMark(r):
+			// Script punctuation such as ฯ, ។ and ။
+			b.WriteByte(' ')
+			i++
 		default:
-			if unicode.Is(unicode.Cyrillic, r) {
-				b.WriteRune(unicode.ToLower(r))
+			j := i
+			for j < len(runes) && unicode.Is(s.script, runes[j]) && runes[j] != s.repeat &&
+				(unicode.IsLetter(runes[j]) || unicode.IsMark(runes[j])) {
+				j++
+			}
+			for _, word := range s.words.segment(runes[i:j], s.cluster) {
+				b.WriteByte(' ')
+				start := b.Len()
+				if roman, ok := s.words.lookup(word); ok && roman != "" {
+					b.WriteString(roman)
+				} else {
+					s.romanize([]rune(word), b)
+				}
+				last = b.String()[start:]
+				b.WriteByte(' ')
 			}
+			i = j
+		}
+	}
+}
+
+// taiScript describes Thai or Lao for transliterateTai. Lao vowel signs and
+// tone marks share the layout of the Thai ones and are shifted onto them
+// before parsing.
+type taiScript struct {
+	consonants        map[rune][2]string // Initial and final spelling of each consonant
+	clusters          map[string]string  // Initial consonant clusters
+	vowels            map[string]string  // Respellings of the RTGS vowels
+	ho, o, ro, wo, yo rune               // The letters for h, the vowel carrier, r, w and y
+	sonorants         string             // Consonants written after a silent ho
+}
+
+var (
+	thaiScript = &taiScript{
+		consonants: map[rune][2]string{
+			'ก': {"k", "k"}, 'ข': {"kh", "k"}, 'ฃ': {"kh", "k"}, 'ค': {"kh", "k"}, 'ฅ': {"kh", "k"},
+			'ฆ': {"kh", "k"}, 'ง': {"ng", "ng"}, 'จ': {"ch", "t"}, 'ฉ': {"ch", "t"}, 'ช': {"ch", "t"},
+			'ซ': {"s", "t"}, 'ฌ': {"ch", "t"}, 'ญ': {"y", "n"}, 'ฎ': {"d", "t"}, 'ฏ': {"t", "t"},
+			'ฐ': {"th", "t"}, 'ฑ': {"th", "t"}, 'ฒ': {"th", "t"}, 'ณ': {"n", "n"}, 'ด': {"d", "t"},
+			'ต': {"t", "t"}, 'ถ': {"th", "t"}, 'ท': {"th", "t"}, 'ธ': {"th", "t"}, 'น': {"n", "n"},
+			'บ': {"b", "p"}, 'ป': {"p", "p"}, 'ผ': {"ph", "p"}, 'ฝ': {"f", "p"}, 'พ': {"ph", "p"},
+			'ฟ': {"f", "p"}, 'ภ': {"ph", "p"}, 'ม': {"m", "m"}, 'ย': {"y", "i"}, 'ร': {"r", "n"},
+			'ล': {"l", "n"}, 'ว': {"w", "o"}, 'ศ': {"s", "t"}, 'ษ': {"s", "t"}, 'ส': {"s", "t"},
+			'ห': {"h", ""}, 'ฬ': {"l", "n"}, 'อ': {"", ""}, 'ฮ': {"h", ""},
+		},
+		clusters: map[string]string{
+			"กร": "kr", "กล": "kl", "กว": "kw", "ขร": "khr", "ขล": "khl", "ขว": "khw",
+			"คร": "khr", "คล": "khl", "คว": "khw", "ตร": "tr", "ปร": "pr", "ปล": "pl",
+			"พร": "phr", "พล": "phl", "ผล": "phl", "บร": "br", "บล": "bl", "ฟร": "fr",
+			"ฟล": "fl", "ดร": "dr",
+			// ร is silent after these, and ทร reads s
+			"ทร": "s", "จร": "ch", "ซร": "s", "ศร": "s", "สร": "s",
+		},
+		ho: 'ห', o: 'อ', ro: 'ร', wo: 'ว', yo: 'ย',
+		sonorants: "งญนมยรลว",
+	}
+	laoScript = &taiScript{
+		consonants: map[rune][2]string{
+			'ກ': {"k", "k"}, 'ຂ': {"kh", "k"}, 'ຄ': {"kh", "k"}, 'ງ': {"ng", "ng"}, 'ຈ': {"ch", "t"},
+			'ສ': {"s", "t"}, 'ຊ': {"x", "t"}, 'ຍ': {"ny", "i"}, 'ດ': {"d", "t"}, 'ຕ': {"t", "t"},
+			'ຖ': {"th", "t"}, 'ທ': {"th", "t"}, 'ນ': {"n", "n"}, 'ບ': {"b", "p"}, 'ປ': {"p", "p"},
+			'ຜ': {"ph", "p"}, 'ຝ': {"f", "p"}, 'ພ': {"ph", "p"}, 'ຟ': {"f", "p"}, 'ມ': {"m", "m"},
+			'ຢ': {"y", "i"}, 'ຣ': {"r", "n"}, 'ລ': {"l", "n"}, 'ວ': {"v", "o"}, 'ຫ': {"h", ""},
+			'ອ': {"", ""}, 'ຮ': {"h", ""}, 'ໜ': {"n", "n"}, 'ໝ': {"m", "m"},
+		},
+		// Lao spells u, ue, ae and uea the French way
+		vowels: map[string]string{"u": "ou", "ue": "u", "ae": "e", "uea": "ua", "ua": "oua", "oei": "ia"},
+		ho:     'ຫ', o: 'ອ', ro: 'ຣ', wo: 'ວ', yo: 'ຍ',
+		sonorants: "ງຍນມລວຣ",
+	}
+)
+
+// TransliterateThai romanizes Thai with the Royal Thai General System,
+// splitting words with the embedded wordlist.
+func TransliterateThai(input string, b *strings.Builder) {
+	transliterateSpaceless(input, b, &spacelessScript{
+		script: unicode.Thai, words: thaiWords, cluster: taiCluster, zero: '๐', repeat: 'ๆ',
+		romanize: func(word []rune, b *strings.Builder) { transliterateTai(word, b, thaiScript) },
+	})
+}
+
+// TransliterateLao romanizes Lao with the French-based national system,
+// splitting words with the embedded wordlist.
+func TransliterateLao(input string, b *strings.Builder) {
+	transliterateSpaceless(input, b, &spacelessScript{
+		script: unicode.Lao, words: laoWords, cluster: taiCluster, zero: '໐', repeat: 'ໆ',
+		romanize: func(word []rune, b *strings.Builder) { transliterateTai(word, b, laoScript) },
+	})
+}
+
+// isTaiLead reports whether r is a Thai or Lao vowel written before the
+// consonant it follows in speech.
+func isTaiLead(r rune) bool {
+	return r >= 'เ' && r <= 'ไ' || r >= 'ເ' && r <= 'ໄ'
+}
+
+// isTaiSilentLead reports whether r is a silent ห (or อ before ย) that only
+// sets the tone of the sonorant next after it, as in หนัก and อยู่.
+func isTaiSilentLead(r, next rune) bool {
+	switch r {
+	case 'ห':
+		return strings.ContainsRune("งญนมยรลว", next)
+	case 'อ':
+		return next == 'ย'
+	case 'ຫ':
+		return strings.ContainsRune("ງຍນມຣລວ", next)
+	}
+	return false
+}
+
+// taiCluster returns the length of the Thai or Lao cluster at i: a consonant
+// with its leading vowel, silent leading ห, combining marks and following
+// vowels.
+func taiCluster(runes []rune, i int) int {
+	j := i
+	if isTaiLead(runes[j]) && j+1 < len(runes) {
+		j++
+	}
+	if j+1 < len(runes) && isTaiSilentLead(runes[j], runes[j+1]) {
+		j++
+	}
+	j++
+	for j < len(runes) {
+		switch r := runes[j]; {
+		case unicode.Is(unicode.Mn, r), r == 'ะ', r == 'า', r == 'ำ', r == 'ະ', r == 'າ', r == 'ຳ':
+			j++
+		default:
+			return j - i
 		}
 	}
+	return j - i
+}
+
+// transliterateTai romanizes one Thai or Lao word syllable by syllable. Tone
+// marks are dropped, consonants take their final spelling at the end of a
+// syllable, and a syllable without a written vowel reads o when closed and
+// a when open.
+func transliterateTai(word []rune, b *strings.Builder, t *taiScript) {
+	// Shift Lao vowels onto Thai ones and drop tone marks
+	w := make([]rune, 0, len(word))
+	for _, r := range word {
+		switch {
+		case r >= 'ະ' && r <= 'ໍ' && r != 'ົ' && r != 'ຼ' && r != 'ຽ':
+			r -= 0x80
+		case r == 'ຼ':
+			// Subscript lo after ho reads l
+			if len(w) > 0 && w[len(w)-1] == 'ຫ' {
+				w[len(w)-1] = 'ລ'
+			}
+			continue
+		}
+		if r >= '่' && r <= '๋' {
+			continue
+		}
+		w = append(w, r)
+	}
+
+	n := len(w)
+	at := func(i int, r rune) bool { return i < n && w[i] == r }
+	isCons := func(i int) bool {
+		if i < 0 || i >= n {
+			return false
+		}
+		_, ok := t.consonants[w[i]]
+		return ok
+	}
+	// follows reports whether w[i] is a vowel sign written after a consonant
+	follows := func(i int) bool {
+		if i >= n {
+			return false
+		}
+		switch w[i] {
+		case 'ะ', 'ั', 'า', 'ำ', 'ิ', 'ี', 'ึ', 'ื', 'ุ', 'ู', '็', 'ํ', 'ๅ', 'ົ', 'ຽ':
+			return true
+		}
+		return false
+	}
+	// opens reports whether the consonant before w[i] starts a syllable
+	opens := func(i int) bool {
+		return follows(i) ||
+			at(i, t.o) && !follows(i+1) && !at(i+1, t.o) && i+1 < n ||
+			at(i, t.wo) && isCons(i+1) && !follows(i+2) && !at(i+1, t.o)
+	}
+	// silent returns how many runes from i a thanthakhat silences: the
+	// consonant under it, with its i or u, or a whole r cluster (ทร์)
+	silent := func(i int) int {
+		switch {
+		case !isCons(i):
+			return 0
+		case at(i+1, '์'):
+			return 2
+		case (at(i+1, 'ิ') || at(i+1, 'ุ') || at(i+1, t.ro)) && at(i+2, '์'):
+			return 3
+		}
+		return 0
+	}
+	spell := func(v string) string {
+		if s, ok := t.vowels[v]; ok {
+			return s
+		}
+		return v
+	}
+
+	for i := 0; i < n; {
+		if s := silent(i); s > 0 {
+			i += s
+			continue
+		}
+		switch w[i] {
+		case 'ฤ':
+			b.WriteString("rue")
+			i++
+			if at(i, 'ๅ') {
+				i++
+			}
+			continue
+		case 'ฦ':
+			b.WriteString("lue")
+			i++
+			if at(i, 'ๅ') {
+				i++
+			}
+			continue
+		}
+
+		var lead rune
+		if isTaiLead(w[i]) {
+			lead = w[i]
+			i++
+		}
+		if !isCons(i) {
+			if lead == 0 {
+				i++
+			}
+			continue
+		}
+
+		// Initial consonant, a silent ho or o before it, or a cluster
+		if (at(i, t.ho) && isCons(i+1) && strings.ContainsRune(t.sonorants, w[i+1]) &&
+			(opens(i+2) || lead == 'ใ' || lead == 'ไ' || lead == 0 && isCons(i+2))) ||
+			(at(i, t.o) && at(i+1, t.yo)) {
+			i++
+		}
+		initial := t.consonants[w[i]][0]
+		if i+1 < n {
+			if cluster, ok := t.clusters[string(w[i:i+2])]; ok &&
+				(lead != 0 || opens(i+2) || isCons(i+2) && !opens(i+3) && w[i+1] != t.wo) &&
+				!(w[i+1] == t.ro && at(i+2, t.ro)) {
+				initial = cluster
+				i++
+			}
+		}
+		i++
+
+		// Vowel
+		vowel, closed, rorhan := "", false, false
+		short := lead == 0 && (at(i, 'ั') || at(i, 'ิ') || at(i, 'ึ') || at(i, 'ุ') || at(i, '็') || at(i, 'ົ')) ||
+			(lead == 'เ' || lead == 'แ') && (at(i, '็') || at(i, 'ั'))
+		switch lead {
+		case 'เ':
+			switch {
+			case at(i, 'ี') && at(i+1, t.yo):
+				vowel, i = "ia", i+2
+			case at(i, 'ื') && at(i+1, t.o):
+				vowel, i = "uea", i+2
+			case at(i, 'ົ') && at(i+1, 'า'):
+				vowel, i = "ao", i+2
+			case at(i, 'า'):
+				vowel, i = "ao", i+1
+				if at(i, 'ะ') {
+					vowel, closed, i = "o", true, i+1
+				}
+			case at(i, 'ิ'), at(i, 'ี'):
+				vowel, i = "oe", i+1
+			case at(i, t.o) && !opens(i+1):
+				vowel, i = "oe", i+1
+			case at(i, '็'), at(i, 'ั'):
+				vowel, i = "e", i+1
+			case at(i, t.yo) && !opens(i+1):
+				vowel, closed, i = "oei", true, i+1
+			default:
+				vowel = "e"
+			}
+			if at(i, 'ะ') {
+				closed, i = true, i+1
+			}
+		case 'แ':
+			vowel = "ae"
+			if at(i, '็') {
+				i++
+			}
+		case 'โ':
+			vowel = "o"
+		case 'ใ', 'ไ':
+			vowel, closed = "ai", !at(i, t.yo)
+		default:
+			switch {
+			case (at(i, 'ั') || at(i, 'ົ')) && at(i+1, t.wo):
+				vowel, i = "ua", i+2
+			case at(i, 'ั'):
+				vowel, i = "a", i+1
+			case at(i, 'ົ'):
+				vowel, i = "o", i+1
+			case at(i, 'ຽ'):
+				vowel, i = "ia", i+1
+			case at(i, 'ำ'):
+				vowel, closed, i = "am", true, i+1
+			case at(i, 'ํ') && at(i+1, 'า'):
+				vowel, closed, i = "am", true, i+2
+			case at(i, 'า'):
+				vowel, i = "a", i+1
+			case at(i, 'ะ'):
+				vowel, closed, i = "a", true, i+1
+			case at(i, 'ิ'), at(i, 'ี'):
+				vowel, i = "i", i+1
+			case at(i, 'ึ'):
+				vowel, i = "ue", i+1
+			case at(i, 'ื'):
+				vowel, i = "ue", i+1
+				if at(i, t.o) {
+					i++
+				}
+			case at(i, 'ุ'), at(i, 'ู'):
+				vowel, i = "u", i+1
+			case at(i, '็'), at(i, 'ํ'):
+				vowel, i = "o", i+1
+			case at(i, t.o) && !opens(i+1):
+				vowel, i = "o", i+1
+			case at(i, t.wo) && isCons(i+1) && !opens(i+2):
+				vowel, i = "ua", i+1
+			case at(i, t.ro) && at(i+1, t.ro):
+				vowel, rorhan, i = "a", true, i+2
+			}
+		}
+		if at(i, 'ะ') {
+			closed, i = true, i+1
+		}
+
+		// Final consonant, then any letters a thanthakhat silences. After a
+		// long or unwritten vowel, a consonant followed only by the last
+		// one of the word opens a syllable of its own (ชาชน cha-chon).
+		final := ""
+		if !closed && isCons(i) && !opens(i+1) && silent(i) == 0 &&
+			(short || !isCons(i+1) || i+2 < n) {
+			final = t.consonants[w[i]][1]
+			i++
+		}
+		if s := silent(i); s > 0 && !opens(i+s) {
+			i += s
+		}
+		if final == "" && rorhan {
+			final = "n"
+		}
+		switch {
+		case vowel == "" && final != "":
+			vowel = "o"
+		case vowel == "":
+			vowel = "a"
+		}
+		vowel = spell(vowel)
+		if final == "i" && strings.HasSuffix(vowel, "i") {
+			final = ""
+		}
+
+		b.WriteString(initial)
+		b.WriteString(vowel)
+		b.WriteString(final)
+	}
+}
+
+// Khmer consonants belong to one of two series that give the same vowel
+// sign different readings. khmerVowels holds both readings of each vowel,
+// following UNGEGN with diacritics folded.
+var (
+	khmerConsonants = map[rune]struct {
+		initial, final string
+		series         int
+	}{
+		'ក': {"k", "k", 0}, 'ខ': {"kh", "k", 0}, 'គ': {"k", "k", 1}, 'ឃ': {"kh", "k", 1},
+		'ង': {"ng", "ng", 1}, 'ច': {"ch", "ch", 0}, 'ឆ': {"chh", "ch", 0}, 'ជ': {"ch", "ch", 1},
+		'ឈ': {"chh", "ch", 1}, 'ញ': {"nh", "nh", 1}, 'ដ': {"d", "d", 0}, 'ឋ': {"th", "t", 0},
+		'ឌ': {"d", "t", 1}, 'ឍ': {"th", "t", 1}, 'ណ': {"n", "n", 0}, 'ត': {"t", "t", 0},
+		'ថ': {"th", "t", 0}, 'ទ': {"t", "t", 1}, 'ធ': {"th", "t", 1}, 'ន': {"n", "n", 1},
+		'ប': {"b", "b", 0}, 'ផ': {"ph", "p", 0}, 'ព': {"p", "p", 1}, 'ភ': {"ph", "p", 1},
+		'ម': {"m", "m", 1}, 'យ': {"y", "y", 1}, 'រ': {"r", "r", 1}, 'ល': {"l", "l", 1},
+		'វ': {"v", "v", 1}, 'ស': {"s", "s", 0}, 'ហ': {"h", "h", 0}, 'ឡ': {"l", "l", 0},
+		'អ': {"", "", 0},
+	}
+	khmerVowels = map[string][2]string{
+		"": {"a", "o"}, "ា": {"a", "ea"}, "ិ": {"e", "i"}, "ី": {"ei", "i"}, "ឹ": {"oe", "oe"},
+		"ឺ": {"oe", "oe"}, "ុ": {"o", "u"}, "ូ": {"o", "u"}, "ួ": {"uo", "uo"}, "ើ": {"aeu", "eu"},
+		"ឿ": {"oea", "oea"}, "ៀ": {"ie", "ie"}, "េ": {"e", "e"}, "ែ": {"e", "e"}, "ៃ": {"ai", "ey"},
+		"ោ": {"ao", "ou"}, "ៅ": {"au", "ov"}, "ុំ": {"om", "um"}, "ំ": {"am", "um"}, "ាំ": {"am", "oam"},
+		"ះ": {"ah", "eah"}, "ិះ": {"eh", "ih"}, "ុះ": {"oh", "uh"}, "េះ": {"eh", "ih"}, "ោះ": {"oh", "uoh"},
+	}
+	khmerIndependent = map[rune]string{
+		'ឣ': "a", 'ឤ': "a", 'ឥ': "e", 'ឦ': "ei", 'ឧ': "o", 'ឩ': "u", 'ឪ': "ov", 'ឫ': "rue",
+		'ឬ': "rue", 'ឭ': "lue", 'ឮ': "lue", 'ឯ': "ae", 'ឰ': "ai", 'ឱ': "ao", 'ឲ': "ao", 'ឳ': "au",
+	}
+)
+
+const (
+	khmerCoeng     = '្'
+	khmerSonorants = "ងញនមយរលវ"
+)
+
+// TransliterateKhmer romanizes Khmer following UNGEGN with diacritics folded,
+// splitting words with the embedded wordlist.
+func TransliterateKhmer(input string, b *strings.Builder) {
+	transliterateSpaceless(input, b, &spacelessScript{
+		script: unicode.Khmer, words: khmerWords, cluster: khmerCluster, zero: '០',
+		romanize: transliterateKhmer,
+	})
+}
+
+// khmerCluster returns the length of the Khmer cluster at i: a letter with
+// its subscript consonants and signs.
+func khmerCluster(runes []rune, i int) int {
+	j := i + 1
+	for j < len(runes) && unicode.IsMark(runes[j]) {
+		if runes[j] == khmerCoeng && j+1 < len(runes) {
+			j++
+		}
+		j++
+	}
+	return j - i
+}
+
+// transliterateKhmer romanizes one Khmer word. A consonant before a subscript
+// closes the syllable before it, and the subscript opens the next one.
+func transliterateKhmer(w []rune, b *strings.Builder) {
+	n := len(w)
+	isCons := func(i int) bool {
+		if i < 0 || i >= n {
+			return false
+		}
+		_, ok := khmerConsonants[w[i]]
+		return ok
+	}
+	isVowel := func(i int) bool {
+		return i < n && w[i] >= 'ា' && w[i] <= 'ះ'
+	}
+
+	for i := 0; i < n; {
+		if w[i] == khmerCoeng {
+			if i+2 == n {
+				// A subscript ending the word is silent
+				break
+			}
+			i++
+			continue
+		}
+		if v, ok := khmerIndependent[w[i]]; ok {
+			b.WriteString(v)
+			i++
+			continue
+		}
+		if !isCons(i) || i+1 < n && w[i+1] == '៍' {
+			// Letters under toandakhiat are silent
+			i++
+			continue
+		}
+
+		c := khmerConsonants[w[i]]
+		series := c.series
+		if w[i] == 'ប' && i+1 < n && (w[i+1] == khmerCoeng || w[i+1] == '៉') {
+			c.initial = "p"
+		}
+		b.WriteString(c.initial)
+		for i++; i+1 < n && w[i] == khmerCoeng && isCons(i+1); i += 2 {
+			sub := khmerConsonants[w[i+1]]
+			b.WriteString(sub.initial)
+			if !strings.ContainsRune(khmerSonorants, w[i+1]) {
+				// The cluster reads in the series of a subscript stop
+				series = sub.series
+			}
+		}
+		for ; i < n && (w[i] == '៉' || w[i] == '៊'); i++ {
+			// Series shifters
+			series = 0
+			if w[i] == '៊' {
+				series = 1
+			}
+		}
+
+		start := i
+		for isVowel(i) {
+			i++
+		}
+		vowel, ok := khmerVowels[string(w[start:i])]
+		if !ok {
+			// Unknown combinations read sign by sign
+			for _, r := range w[start:i] {
+				vowel[series] += khmerVowels[string(r)][series]
+			}
+		}
+		b.WriteString(vowel[series])
+		closed := i > start && (w[i-1] == 'ះ' || w[i-1] == 'ំ')
+		for i < n && (w[i] == '់' || w[i] == '៎' || w[i] == '៏' || w[i] == '័' || w[i] == '៑') {
+			i++
+		}
+
+		// A consonant followed by a lone final or a consonant with a
+		// subscript opens a syllable of its own
+		opens := isCons(i+1) && (i+2 == n || w[i+2] == khmerCoeng || w[i+2] == '់')
+		if !closed && isCons(i) && !isVowel(i+1) && !opens && !(i+1 < n && (w[i+1] == '៉' || w[i+1] == '៊')) {
+			if i+1 < n && w[i+1] == '៍' {
+				// Silenced by toandakhiat
+				i += 2
+				continue
+			}
+			b.WriteString(khmerConsonants[w[i]].final)
+			i++
+			for i < n && (w[i] == '់' || w[i] == '៍' || w[i] == '៌') {
+				i++
+			}
+		}
+	}
+}
+
+// Burmese practical romanization, as used for place names: medials write y,
+// w and h, and the vowel and final consonant of a syllable read together.
+var (
+	burmeseConsonants = map[rune]string{
+		'က': "k", 'ခ': "kh", 'ဂ': "g", 'ဃ': "g", 'င': "ng", 'စ': "s", 'ဆ': "hs", 'ဇ': "z",
+		'ဈ': "z", 'ဉ': "ny", 'ည': "ny", 'ဋ': "t", 'ဌ': "ht", 'ဍ': "d", 'ဎ': "d", 'ဏ': "n",
+		'တ': "t", 'ထ': "ht", 'ဒ': "d", 'ဓ': "d", 'န': "n", 'ပ': "p", 'ဖ': "hp", 'ဗ': "b",
+		'ဘ': "b", 'မ': "m", 'ယ': "y", 'ရ': "y", 'လ': "l", 'ဝ': "w", 'သ': "th", 'ဟ': "h",
+		'ဠ': "l", 'အ': "",
+	}
+	// burmeseFinals spells a final consonant after each vowel; finals are
+	// grouped by the stop or nasal they are read as
+	burmeseFinals = map[string]map[rune]string{
+		"":   {'က': "et", 'င': "in", 'စ': "it", 'ည': "i", 'ဉ': "in", 'တ': "at", 'ပ': "at", 'ဏ': "an", 'န': "an", 'မ': "an", 'ယ': "e"},
+		"i":  {'တ': "eik", 'ပ': "eik", 'န': "ein", 'မ': "ein"},
+		"u":  {'တ': "ok", 'ပ': "ok", 'န': "on", 'မ': "on"},
+		"o":  {'က': "aik", 'င': "aing"},
+		"aw": {'က': "auk", 'င': "aung"},
+		"w":  {'တ': "ut", 'ပ': "ut", 'န': "un", 'မ': "un"},
+	}
+	burmeseIndependent = map[rune]string{
+		'ဣ': "i", 'ဤ': "i", 'ဥ': "u", 'ဦ': "u", 'ဧ': "e", 'ဩ': "aw", 'ဪ': "aw", 'ဿ': "ss",
+	}
+)
+
+const (
+	burmeseAsat   = '်'
+	burmeseVirama = '္'
+)
+
+// TransliterateBurmese romanizes Burmese with the practical system used for
+// place names, splitting words with the embedded wordlist.
+func TransliterateBurmese(input string, b *strings.Builder) {
+	transliterateSpaceless(input, b, &spacelessScript{
+		script: unicode.Myanmar, words: burmeseWords, cluster: burmeseCluster, zero: '၀',
+		romanize: transliterateBurmese,
+	})
+}
+
+// burmeseCluster returns the length of the Burmese syllable at i, including
+// its stacked and killed final consonants.
+func burmeseCluster(runes []rune, i int) int {
+	n := len(runes)
+	j := i + 1
+	for j < n {
+		switch {
+		case runes[j] == burmeseVirama && j+1 < n:
+			j += 2
+		case unicode.IsMark(runes[j]):
+			j++
+		case j+1 < n && (runes[j+1] == burmeseAsat ||
+			runes[j+1] == '့' && j+2 < n && runes[j+2] == burmeseAsat):
+			// A killed consonant closes this syllable
+			j += 2
+		default:
+			return j - i
+		}
+	}
+	return j - i
+}
+
+// transliterateBurmese romanizes one Burmese word.
+func transliterateBurmese(w []rune, b *strings.Builder) {
+	n := len(w)
+	at := func(i int, r rune) bool { return i < n && w[i] == r }
+	isCons := func(i int) bool {
+		if i >= n {
+			return false
+		}
+		_, ok := burmeseConsonants[w[i]]
+		return ok
+	}
+	// killed reports whether the consonant at i ends a syllable
+	killed := func(i int) int {
+		switch {
+		case at(i+1, burmeseAsat):
+			return 2
+		case at(i+1, '့') && at(i+2, burmeseAsat):
+			return 3
+		case at(i+1, burmeseVirama):
+			return 2
+		}
+		return 0
+	}
+
+	for i := 0; i < n; {
+		if v, ok := burmeseIndependent[w[i]]; ok {
+			b.WriteString(v)
+			i++
+			continue
+		}
+		if !isCons(i) {
+			i++
+			continue
+		}
+
+		initial := burmeseConsonants[w[i]]
+		i++
+		medial, aspirated := "", false
+		for ; i < n; i++ {
+			switch w[i] {
+			case 'ျ', 'ြ':
+				medial += "y"
+				continue
+			case 'ွ':
+				medial += "w"
+				continue
+			case 'ှ':
+				aspirated = true
+				continue
+			}
+			break
+		}
+		if aspirated {
+			if initial == "y" {
+				initial = "sh"
+			} else {
+				initial = "h" + initial
+			}
+		}
+		if initial == "kh" && strings.HasPrefix(medial, "y") {
+			initial, medial = "ch", medial[1:]
+		}
+
+		// Vowel signs, ignoring tone marks
+		vowel, anusvara := "", false
+		for ; i < n; i++ {
+			switch w[i] {
+			case 'ေ':
+				vowel += "e"
+			case 'ာ', 'ါ':
+				vowel += "a"
+			case 'ိ', 'ီ':
+				vowel += "i"
+			case 'ု', 'ူ':
+				vowel += "u"
+			case 'ဲ':
+				vowel += "ai"
+			case 'ံ':
+				anusvara = true
+			case '့', 'း', burmeseAsat:
+			default:
+				goto done
+			}
+		}
+	done:
+		switch vowel {
+		case "ea":
+			vowel = "aw"
+		case "iu":
+			vowel = "o"
+		case "e":
+			vowel = "ay"
+		case "ai":
+			vowel = "e"
+		}
+		if medial == "w" && vowel == "" {
+			medial, vowel = "", "w"
+		}
+		if initial == "w" && medial == "" && vowel == "" && isCons(i) && killed(i) > 0 {
+			// Wa before a final reads as the medial w
+			vowel = "w"
+		}
+
+		spelled := ""
+		if isCons(i) {
+			if k := killed(i); k > 0 {
+				final := w[i]
+				if table, ok := burmeseFinals[vowel]; ok && table[final] != "" {
+					spelled = table[final]
+				} else {
+					spelled = vowel + burmeseFinals[""][final]
+				}
+				if spelled == "" {
+					spelled = vowel + burmeseConsonants[final]
+				}
+				// A consonant stacked under a virama opens the next syllable
+				i += k
+			}
+		}
+		switch {
+		case spelled != "":
+		case anusvara && vowel == "u":
+			spelled = "on"
+		case anusvara && vowel == "i":
+			spelled = "ein"
+		case anusvara:
+			spelled = vowel + "an"
+		case vowel == "w":
+			spelled = "wa"
+		case vowel == "":
+			spelled = "a"
+		default:
+			spelled = vowel
+		}
+
+		b.WriteString(initial)
+		b.WriteString(medial)
+		b.WriteString(spelled)
+	}
 }
 
 // transliterateGeneric handles basic Latin normalization.
@@ -159,9 +2259,13 @@ func TransliterateGeneric(input string, b *strings.Builder) {
 	}
 }
 
-// transliterateUnidecode default (no dependency) version.
+// TransliterateUnidecode replaces every non-ASCII character with its closest
+// ASCII approximation from the embedded unidecode tables. Characters without
+// one are dropped, so the output is always pure ASCII.
 func TransliterateUnidecode(input string, b *strings.Builder) {
-	TransliterateGeneric(input, b)
+	for _, r := range input {
+		b.WriteString(unidecodeRune(r))
+	}
 }
 
 // transliterateASCIISafe ensures a non-empty ASCII output as a last resort.
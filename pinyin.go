@@ -0,0 +1,112 @@
+package slugcraft
+
+import (
+	"bufio"
+	"bytes"
+	"compress/gzip"
+	_ "embed"
+	"strconv"
+	"strings"
+	"sync"
+)
+
+// The pinyin table maps Simplified and Traditional Chinese characters to
+// their most common toneless reading. It is derived from
+// github.com/mozillazg/go-pinyin (MIT License, see data/pinyin.LICENSE) and
+// regenerated with:
+//
+//go:generate go run gen_pinyin.go -module github.com/mozillazg/go-pinyin@v0.20.0 -src pinyin_dict.go
+//go:embed data/pinyin.gz
+var pinyinData []byte
+
+var (
+	pinyinOnce  sync.Once
+	pinyinTable map[rune]string
+)
+
+// pinyinWords overrides the per-character reading for common words whose
+// characters are polyphones.
+var pinyinWords = map[string]string{
+	// 长 chang / zhang
+	"长城": "chang cheng", "長城": "chang cheng", "长江": "chang jiang", "長江": "chang jiang",
+	"长沙": "chang sha", "長沙": "chang sha", "长安": "chang an", "長安": "chang an",
+	"长度": "chang du", "長度": "chang du", "长期": "chang qi", "長期": "chang qi",
+	// 行 xing / hang
+	"银行": "yin hang", "銀行": "yin hang", "行业": "hang ye", "行業": "hang ye",
+	"一行": "yi hang", "排行": "pai hang", "同行": "tong hang",
+	// 重 zhong / chong
+	"重庆": "chong qing", "重慶": "chong qing", "重新": "chong xin", "重复": "chong fu",
+	"重複": "chong fu", "重建": "chong jian",
+	// 乐 le / yue
+	"音乐": "yin yue", "音樂": "yin yue", "乐队": "yue dui", "樂隊": "yue dui",
+	"乐器": "yue qi", "樂器": "yue qi",
+	// 调 diao / tiao
+	"调整": "tiao zheng", "調整": "tiao zheng", "空调": "kong tiao", "空調": "kong tiao",
+	"调查": "diao cha", "調查": "diao cha",
+	// 了 le / liao
+	"了解": "liao jie", "了不起": "liao bu qi",
+	// 还 hai / huan
+	"还原": "huan yuan", "還原": "huan yuan", "归还": "gui huan", "歸還": "gui huan",
+	// 都 dou / du
+	"首都": "shou du", "都市": "du shi", "成都": "cheng du", "京都": "jing du",
+	// 觉 jue / jiao
+	"睡觉": "shui jiao", "睡覺": "shui jiao",
+	// 着 zhe / zhao / zhuo
+	"着急": "zhao ji", "著急": "zhao ji", "着陆": "zhuo lu", "著陸": "zhuo lu",
+	// 朝 chao / zhao
+	"朝鲜": "chao xian", "朝鮮": "chao xian", "王朝": "wang chao", "朝代": "chao dai",
+	// 发 fa / fa (髮)
+	"头发": "tou fa", "理发": "li fa",
+	// 的 de / di
+	"目的": "mu di", "的确": "di que", "的確": "di que",
+	// 传 chuan / zhuan
+	"传记": "zhuan ji", "傳記": "zhuan ji",
+	// Others
+	"厦门": "xia men", "廈門": "xia men", "便宜": "pian yi", "会计": "kuai ji",
+	"會計": "kuai ji", "角色": "jue se", "曾经": "ceng jing", "曾經": "ceng jing",
+	"单于": "chan yu", "数据": "shu ju", "數據": "shu ju", "地方": "di fang",
+}
+
+// pinyinMaxWord is the length in runes of the longest key in pinyinWords.
+var pinyinMaxWord = func() int {
+	longest := 0
+	for word := range pinyinWords {
+		if n := len([]rune(word)); n > longest {
+			longest = n
+		}
+	}
+	return longest
+}()
+
+// loadPinyin decodes the embedded table.
+func loadPinyin() {
+	zr, err := gzip.NewReader(bytes.NewReader(pinyinData))
+	if err != nil {
+		panic("slugcraft: corrupt pinyin table: " + err.Error())
+	}
+	defer zr.Close()
+
+	pinyinTable = make(map[rune]string, 42000)
+	scanner := bufio.NewScanner(zr)
+	for scanner.Scan() {
+		key, reading, ok := strings.Cut(scanner.Text(), "\t")
+		if !ok {
+			continue
+		}
+		code, err := strconv.ParseUint(key, 16, 32)
+		if err != nil {
+			continue
+		}
+		pinyinTable[rune(code)] = reading
+	}
+	if err := scanner.Err(); err != nil {
+		panic("slugcraft: corrupt pinyin table: " + err.Error())
+	}
+}
+
+// pinyinRune returns the toneless reading of r, or "" if r is not a known
+// Chinese character.
+func pinyinRune(r rune) string {
+	pinyinOnce.Do(loadPinyin)
+	return pinyinTable[r]
+}
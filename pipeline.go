@@ -12,6 +12,9 @@ import (
 
 // Common transformers for pipeline
 
+// nonSlugChars matches every run of characters that cannot appear in a slug.
+var nonSlugChars = regexp.MustCompile(`[^a-z0-9]+`)
+
 // Lowercase converts text to lowercase in-place.
 func Lowercase() Transformer {
 	return func(b *strings.Builder) {
@@ -24,8 +27,7 @@ func Lowercase() Transformer {
 // ReplaceSpaces replaces spaces with dashes.
 func ReplaceSpaces(delimeter string) Transformer {
 	return func(b *strings.Builder) {
-		re := regexp.MustCompile(`[^a-z0-9]+`)
-		temp := re.ReplaceAllString(b.String(), delimeter)
+		temp := nonSlugChars.ReplaceAllString(b.String(), delimeter)
 		b.Reset()
 		b.WriteString(strings.Trim(temp, delimeter))
 	}
@@ -0,0 +1,129 @@
+package slugcraft
+
+import (
+	"bufio"
+	"embed"
+	"strings"
+	"sync"
+	"unicode/utf8"
+)
+
+// Thai, Lao, Khmer and Burmese are written without spaces between words. The
+// embedded wordlists split them into words before romanization. Each line
+// holds a word, optionally followed by a tab and a romanization that
+// overrides the letter rules for it; lines starting with # are comments.
+// The lists cover common news vocabulary and place names only, a few hundred
+// words each at most; text outside them is cut at character clusters.
+//
+//go:embed data/words_*.txt
+var wordlistFS embed.FS
+
+// wordlist is a lazily loaded dictionary for one language.
+type wordlist struct {
+	file   string
+	once   sync.Once
+	words  map[string]string
+	maxLen int // Longest word, in runes
+}
+
+var (
+	thaiWords    = &wordlist{file: "data/words_th.txt"}
+	laoWords     = &wordlist{file: "data/words_lo.txt"}
+	khmerWords   = &wordlist{file: "data/words_km.txt"}
+	burmeseWords = &wordlist{file: "data/words_my.txt"}
+)
+
+// load reads the embedded wordlist.
+func (w *wordlist) load() {
+	f, err := wordlistFS.Open(w.file)
+	if err != nil {
+		panic("slugcraft: missing wordlist: " + err.Error())
+	}
+	defer f.Close()
+
+	w.words = make(map[string]string)
+	scanner := bufio.NewScanner(f)
+	for scanner.Scan() {
+		line := scanner.Text()
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+		word, roman, _ := strings.Cut(line, "\t")
+		w.words[word] = roman
+		w.maxLen = max(w.maxLen, utf8.RuneCountInString(word))
+	}
+	if err := scanner.Err(); err != nil {
+		panic("slugcraft: corrupt wordlist: " + err.Error())
+	}
+}
+
+// lookup returns the romanization listed for word, which may be empty, and
+// whether word is in the list.
+func (w *wordlist) lookup(word string) (string, bool) {
+	w.once.Do(w.load)
+	roman, ok := w.words[word]
+	return roman, ok
+}
+
+// segment splits runes into words by maximal matching: of all the ways to cut
+// runes into listed words and unknown clusters, it picks the one with the
+// fewest unknown runes, then the fewest words. Runs of unknown clusters are
+// kept together as one word. cluster returns the length of the character
+// cluster at i, the smallest unit a word can start or end on.
+func (w *wordlist) segment(runes []rune, cluster func(runes []rune, i int) int) []string {
+	w.once.Do(w.load)
+
+	type cost struct{ unknown, words int }
+	n := len(runes)
+	best := make([]cost, n+1)
+	from := make([]int, n+1)
+	known := make([]bool, n+1)
+	reached := make([]bool, n+1)
+	reached[0] = true
+
+	relax := func(start, end int, c cost, isWord bool) {
+		if !reached[end] || c.unknown < best[end].unknown ||
+			(c.unknown == best[end].unknown && c.words < best[end].words) {
+			best[end], from[end], known[end], reached[end] = c, start, isWord, true
+		}
+	}
+
+	boundary := make([]bool, n+1)
+	for i := 0; i < n; i += cluster(runes, i) {
+		boundary[i] = true
+	}
+	boundary[n] = true
+
+	for i := 0; i < n; i++ {
+		if !boundary[i] || !reached[i] {
+			continue
+		}
+		for end := i + 1; end <= min(n, i+w.maxLen); end++ {
+			if !boundary[end] {
+				continue
+			}
+			if _, ok := w.words[string(runes[i:end])]; ok {
+				relax(i, end, cost{best[i].unknown, best[i].words + 1}, true)
+			}
+		}
+		size := cluster(runes, i)
+		relax(i, i+size, cost{best[i].unknown + size, best[i].words + 1}, false)
+	}
+
+	// Walk back from the end, merging neighbouring unknown clusters
+	var words []string
+	for end := n; end > 0; {
+		start := from[end]
+		if !known[end] {
+			for start > 0 && !known[start] {
+				start = from[start]
+			}
+		}
+		words = append(words, string(runes[start:end]))
+		end = start
+	}
+	for i, j := 0, len(words)-1; i < j; i, j = i+1, j-1 {
+		words[i], words[j] = words[j], words[i]
+	}
+	return words
+}
@@ -2,10 +2,23 @@ package slugcraft
 
 import (
 	"context"
+	"errors"
 	"strings"
 )
 
+// maxSuffixAttempts bounds how many suffixed candidates EnsureUnique tries.
+const maxSuffixAttempts = 100000
+
+// ErrSuffixExhausted is returned when no free slug was found within the
+// attempt limit, e.g. because a SuffixFunc keeps returning taken suffixes.
+var ErrSuffixExhausted = errors.New("slugcraft: no unique slug found")
+
+// ErrSuffixTooLong is returned when MaxLength leaves no room for a suffix and
+// at least one rune of the slug it is appended to.
+var ErrSuffixTooLong = errors.New("slugcraft: suffix does not fit in MaxLength")
+
 // Make generates a slug from the input string with the configured options.
+// It is safe to call Make concurrently on the same Config.
 func (cfg *Config) Make(ctx context.Context, input string) (string, error) {
 	if input == "" {
 		return "", nil
@@ -14,76 +27,71 @@ func (cfg *Config) Make(ctx context.Context, input string) (string, error) {
 		return "", err
 	}
 
-	// Initialize builder for both modes
-	cfg.Builder.Reset()
-	cfg.Builder.Grow(len(input))
-	cfg.Builder.WriteString(input)
+	// Every call works on its own buffer
+	b := new(strings.Builder)
+	b.Grow(len(input))
+	b.WriteString(input)
 
 	// Apply abbreviations
-	if cfg.Abbreviations != nil {
-		var temp strings.Builder
-		temp.WriteString(cfg.Builder.String())
-		for from, to := range cfg.Abbreviations {
-			tempStr := strings.ReplaceAll(temp.String(), from, to)
-			temp.Reset()
-			temp.WriteString(tempStr)
-		}
-		cfg.Builder.Reset()
-		cfg.Builder.WriteString(temp.String())
+	if cfg.abbr != nil {
+		temp := cfg.abbr.replace(b.String())
+		b.Reset()
+		b.WriteString(temp)
 	}
 
 	// Remove stopwords
 	if cfg.StopWords != nil {
-		words := strings.Fields(cfg.Builder.String())
-		cfg.Builder.Reset()
+		words := strings.Fields(b.String())
+		b.Reset()
 		for i, w := range words {
 			if _, ok := cfg.StopWords[strings.ToLower(w)]; !ok {
 				if i > 0 {
-					cfg.Builder.WriteByte(' ')
+					b.WriteByte(' ')
 				}
-				cfg.Builder.WriteString(w)
+				b.WriteString(w)
 			}
 		}
 	}
 
 	// Apply language-specific transliteration
-	if cfg.Language != "" {
-		result, err := cfg.Transliterate(cfg.Builder.String()) // Use builder output only
+	if cfg.Language != "" || cfg.UseUnidecode {
+		result, err := cfg.Transliterate(b.String())
 		if err != nil {
-			return "", err // Fix: return error
+			return "", err
 		}
-		cfg.Builder.Reset()
-		cfg.Builder.WriteString(result)
+		b.Reset()
+		b.WriteString(result)
 	}
 
 	// Apply pipeline transformations
 	for _, t := range cfg.PipeLine {
-		t(&cfg.Builder)
+		t(b)
 	}
 
 	// Apply regex filter
 	if cfg.RegexFilter != nil {
-		temp := cfg.RegexFilter.ReplaceAllString(cfg.Builder.String(), cfg.RegexReplace)
-		cfg.Builder.Reset()
-		cfg.Builder.WriteString(temp)
+		temp := cfg.RegexFilter.ReplaceAllString(b.String(), cfg.RegexReplace)
+		b.Reset()
+		b.WriteString(temp)
 	}
 
+	result := b.String()
+
 	// Truncate to max length
-	if cfg.MaxLength > 0 && cfg.Builder.Len() > cfg.MaxLength {
-		runes := []rune(cfg.Builder.String())
-		if len(runes) > cfg.MaxLength {
-			cfg.Builder.Reset()
-			cfg.Builder.WriteString(string(runes[:cfg.MaxLength]))
-		}
+	if cfg.MaxLength > 0 && len(result) > cfg.MaxLength {
+		result = cfg.truncate(result, cfg.MaxLength)
 	}
 
-	// Handle uniqueness with in-memory cache
+	// Handle uniqueness against the configured store
 	if cfg.UseCache {
-		cfg.EnsureUnique(ctx)
+		unique, err := cfg.EnsureUnique(ctx, result)
+		if err != nil {
+			return "", err
+		}
+		result = unique
 	}
 
 	// Return final result
-	result := cfg.Builder.String()
 	if !cfg.ZeroAlloc {
 		// Non-zero-alloc mode creates a copy (for compatibility)
 		slug := make([]byte, len(result))
@@ -109,37 +117,88 @@ func (cfg *Config) MakeBulk(ctx context.Context, inputs []string) ([]string, err
 	return slugs, nil
 }
 
-// EnsureUnique ensures the slug is unique using the in-memory cache.
-func (cfg *Config) EnsureUnique(ctx context.Context) {
-	if err := ctx.Err(); err != nil {
-		return
+// EnsureUnique returns slug, or slug with a suffix appended, after reserving
+// it in the configured UniquenessStore. Suffixes come from SuffixFunc when set
+// and from SuffixStyle otherwise; they are generated for increasing attempts
+// until the store accepts a candidate. The base is shortened as needed so that
+// no candidate, suffix included, exceeds MaxLength.
+//
+// The bare slug is always tried first, so released slugs are handed out again.
+// For the counting styles ("numeric", "version", "revision") the Config then
+// resumes after the last suffix it handed out for the base, so the Nth
+// duplicate costs two Reserve calls instead of N.
+func (cfg *Config) EnsureUnique(ctx context.Context, slug string) (string, error) {
+	next := cfg.SuffixFunc
+	if next == nil {
+		next = cfg.suffix
+	}
+	counting := cfg.countingSuffix()
+
+	for attempt, tries := 0, 0; tries < maxSuffixAttempts; tries++ {
+		if err := ctx.Err(); err != nil {
+			return "", err
+		}
+		suffix := ""
+		if attempt > 0 {
+			suffix = next(slug, attempt)
+		}
+		candidate, err := cfg.fit(slug, suffix)
+		if err != nil {
+			return "", err
+		}
+		ok, err := cfg.Store.Reserve(ctx, candidate)
+		if err != nil {
+			return "", err
+		}
+		if ok {
+			if counting && attempt > 0 {
+				cfg.noteSuffix(slug, attempt)
+			}
+			return candidate, nil
+		}
+		attempt++
+		if counting && attempt == 1 {
+			if last, ok := cfg.suffixHints.Load(slug); ok {
+				attempt = last.(int) + 1
+			}
+		}
 	}
+	return "", ErrSuffixExhausted
+}
 
-	baseSlug := cfg.Builder.String()
-	cfg.Cache.Mu.Lock()
-	defer cfg.Cache.Mu.Unlock()
+// maxSuffixHints bounds how many bases EnsureUnique remembers a last suffix
+// for; the hints are dropped wholesale once it is reached.
+const maxSuffixHints = 4096
 
-	count, exists := cfg.Cache.Store[baseSlug]
-	if !exists {
-		cfg.Cache.Store[baseSlug] = 0
-		return
+// countingSuffix reports whether suffixes count up with the attempt, so that
+// resuming after the last one handed out skips only taken slugs.
+func (cfg *Config) countingSuffix() bool {
+	if cfg.SuffixFunc != nil {
+		return false
 	}
-
-	count++
-	cfg.Cache.Store[baseSlug] = count
-	cfg.Builder.WriteByte('-')
 	switch cfg.SuffixStyle {
-	case "numeric":
-		cfg.Builder.WriteString(itoa(count))
-	case "version":
-		cfg.Builder.WriteString("v")
-		cfg.Builder.WriteString(itoa(count))
-	case "revision":
-		cfg.Builder.WriteString("rev")
-		cfg.Builder.WriteString(itoa(count))
-	}
-	finalSlug := cfg.Builder.String()
-	cfg.Cache.Store[finalSlug] = 0
+	case "numeric", "version", "revision":
+		return true
+	}
+	return false
+}
+
+// noteSuffix records attempt as the last one handed out for base, unless a
+// concurrent call already got further.
+func (cfg *Config) noteSuffix(base string, attempt int) {
+	for {
+		last, loaded := cfg.suffixHints.LoadOrStore(base, attempt)
+		if !loaded {
+			if cfg.suffixHintCount.Add(1) > maxSuffixHints {
+				cfg.suffixHints.Clear()
+				cfg.suffixHintCount.Store(0)
+			}
+			return
+		}
+		if last.(int) >= attempt || cfg.suffixHints.CompareAndSwap(base, last, attempt) {
+			return
+		}
+	}
 }
 
 // itoa converts an int to string without allocation
@@ -159,26 +218,28 @@ func itoa(n int) string {
 
 // Transliterate converts text to a Latin-based slug using language-specific rules
 func (cfg *Config) Transliterate(input string) (string, error) {
-	cfg.Builder.Reset()
-	cfg.Builder.Grow(len(input))
-
-	switch cfg.Language {
-	case "bn":
-		TransliterateBangla(input, &cfg.Builder)
-	case "ru":
-		TransliterateRussian(input, &cfg.Builder)
-	default:
-		if cfg.UseUnidecode {
-			TransliterateUnidecode(input, &cfg.Builder)
-		} else {
-			TransliterateGeneric(input, &cfg.Builder)
-		}
+	b := new(strings.Builder)
+	b.Grow(len(input))
+
+	if t := cfg.transliterator(cfg.Language); t != nil {
+		t.Transliterate(input, b)
+	} else if cfg.UseUnidecode {
+		TransliterateUnidecode(input, b)
+	} else {
+		TransliterateGeneric(input, b)
+	}
+
+	// Unidecode fallback for anything the language rules left untouched
+	if cfg.UseUnidecode && !isASCII(b.String()) {
+		temp := b.String()
+		b.Reset()
+		TransliterateUnidecode(temp, b)
 	}
 
 	// Fail-safe ASCII fallback
-	if cfg.Builder.Len() == 0 {
-		transliterateASCIISafe(input, &cfg.Builder)
+	if b.Len() == 0 {
+		transliterateASCIISafe(input, b)
 	}
 
-	return cfg.Builder.String(), nil
+	return b.String(), nil
 }
@@ -2,7 +2,11 @@ package slugcraft
 
 import (
 	"context"
+	"regexp"
+	"strings"
+	"sync"
 	"testing"
+	"time"
 )
 
 // TestNew tests the default Config configuration
@@ -60,8 +64,9 @@ func TestMakeWithLanguage(t *testing.T) {
 		{"bn", "গোলাপ ফুল", "golap-phul"},
 		{"bn", "পাখির গান", "pakhir-gan"},
 		{"bn", "রাতের তারা", "rater-tara"},
-		{"bn", "ক্ষমা করো", "khoma-kro"},
+		{"bn", "ক্ষমা করো", "khoma-koro"},
 		{"ru", "привет мир", "privet-mir"},
+		{"el", "Καλημέρα κόσμε", "kalimera-kosme"},
 	}
 
 	for _, tt := range tests {
@@ -78,6 +83,44 @@ func TestMakeWithLanguage(t *testing.T) {
 	}
 }
 
+// TestMakeWithUnidecode tests that the unidecode fallback produces ASCII slugs for any script.
+func TestMakeWithUnidecode(t *testing.T) {
+	tests := []struct {
+		language string
+		input    string
+		expected string
+	}{
+		{"", "北京欢迎你", "bei-jing-huan-ying-ni"},
+		{"", "Καλημέρα κόσμε", "kalemera-kosme"},
+		{"", "Größe café", "grosse-cafe"},
+		{"", "안녕하세요", "annyeonghaseyo"},
+		{"", "Привет мир", "privet-mir"},
+		{"bn", "বাংলা 北京", "bangla-bei-jing"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.language+"/"+tt.input, func(t *testing.T) {
+			s := New(WithLanguage(tt.language), WithUnidecode(true))
+			slug, err := s.Make(context.Background(), tt.input)
+			if err != nil {
+				t.Errorf("Make(%q) returned error: %v", tt.input, err)
+			}
+			if slug != tt.expected {
+				t.Errorf("Make(%q) = %q, expected %q", tt.input, slug, tt.expected)
+			}
+		})
+	}
+
+	// Every script must come out as pure ASCII
+	s := New(WithUnidecode(true), WithPipeline())
+	for _, input := range []string{"مرحبا بالعالم", "สวัสดีครับ", "שלום עולם", "こんにちは", "नमस्ते दुनिया", "ሰላም", "Ελληνικά"} {
+		slug, _ := s.Make(context.Background(), input)
+		if slug == "" || !isASCII(slug) {
+			t.Errorf("Make(%q) = %q, expected non-empty ASCII", input, slug)
+		}
+	}
+}
+
 // TestMakeWithPipeline tests custom pipeline transformations.
 func TestMakeWithPipeline(t *testing.T) {
 	s := New(WithPipeline(
@@ -176,6 +219,77 @@ func TestMakeWithCacheAndStyles(t *testing.T) {
 	}
 }
 
+// TestMakeWithGeneratedSuffixes tests the timestamp, random, hash and UUID suffix styles.
+func TestMakeWithGeneratedSuffixes(t *testing.T) {
+	now = func() time.Time { return time.Date(2024, 5, 6, 7, 8, 9, 0, time.UTC) }
+	defer func() { now = time.Now }()
+
+	tests := []struct {
+		suffixStyle string
+		options     []Options
+		expected    []string
+	}{
+		{"timestamp", nil, []string{`^test$`, `^test-20240506070809$`, `^test-20240506070809-1$`}},
+		{"unix", nil, []string{`^test$`, `^test-1714979289$`, `^test-1714979289-1$`}},
+		{"shortid", nil, []string{`^test$`, `^test-[0-9a-z]{6}$`, `^test-[0-9a-z]{6}$`}},
+		{"shortid62", []Options{WithSuffixLength(10)}, []string{`^test$`, `^test-[0-9a-zA-Z]{10}$`, `^test-[0-9a-zA-Z]{10}$`}},
+		{"hash", nil, []string{`^test$`, `^test-[0-9a-f]{8}$`, `^test-[0-9a-f]{8}$`}},
+		{"hash", []Options{WithSuffixLength(4), WithSuffixSeparator("_")}, []string{`^test$`, `^test_[0-9a-f]{4}$`, `^test_[0-9a-f]{4}$`}},
+		{"uuid", nil, []string{`^test$`, `^test-[0-9a-f]{8}-[0-9a-f]{4}-4[0-9a-f]{3}-[89ab][0-9a-f]{3}-[0-9a-f]{12}$`, `^test-[0-9a-f-]{36}$`}},
+	}
+
+	for _, tt := range tests {
+		opts := append([]Options{WithUseCache(true), WithSuffixStyle(tt.suffixStyle)}, tt.options...)
+		s := New(opts...)
+		seen := make(map[string]struct{})
+		for _, pattern := range tt.expected {
+			slug, err := s.Make(context.Background(), "Test")
+			if err != nil {
+				t.Errorf("Make(style=%q) returned error: %v", tt.suffixStyle, err)
+			}
+			if !regexp.MustCompile(pattern).MatchString(slug) {
+				t.Errorf("Make(style=%q) = %q, expected match for %s", tt.suffixStyle, slug, pattern)
+			}
+			if _, dup := seen[slug]; dup {
+				t.Errorf("Make(style=%q) returned duplicate %q", tt.suffixStyle, slug)
+			}
+			seen[slug] = struct{}{}
+		}
+	}
+}
+
+// TestMakeWithSuffixFunc tests a user-defined suffix generator.
+func TestMakeWithSuffixFunc(t *testing.T) {
+	store := &takenStore{taken: map[string]bool{"report": true, "report-part-2": true}}
+	s := New(
+		WithUseCache(true),
+		WithStore(store),
+		WithSuffixFunc(func(base string, attempt int) string {
+			return "-part-" + itoa(attempt+1)
+		}),
+	)
+	expected := []string{"report-part-3", "report-part-4"}
+	for _, want := range expected {
+		slug, err := s.Make(context.Background(), "Report")
+		if err != nil {
+			t.Fatalf("Make returned error: %v", err)
+		}
+		if slug != want {
+			t.Errorf("Make = %q, expected %q", slug, want)
+		}
+	}
+
+	// A generator that never yields a free slug must not loop forever
+	s = New(
+		WithUseCache(true),
+		WithStore(store),
+		WithSuffixFunc(func(string, int) string { return "-part-2" }),
+	)
+	if _, err := s.Make(context.Background(), "Report"); err != ErrSuffixExhausted {
+		t.Errorf("Make with exhausted suffixes returned %v, expected %v", err, ErrSuffixExhausted)
+	}
+}
+
 // TestMakeWithoutCache tests slug generation without caching.
 func TestMakeWithoutCache(t *testing.T) {
 	s := New(WithUseCache(false))
@@ -239,6 +353,225 @@ func TestCacheOperations(t *testing.T) {
 	}
 }
 
+// TestMakeConcurrent tests that a shared Config produces stable output under parallel load.
+func TestMakeConcurrent(t *testing.T) {
+	s := New(
+		WithLanguage("bn"),
+		WithStopWords("en"),
+		WithAbbreviation("বাংলা", "BN"),
+	)
+	tests := []struct {
+		input    string
+		expected string
+	}{
+		{"বাংলা the আমি", "bn-ami"},
+		{"আমি তোমাকে", "ami-tomake"},
+		{"Hello a বাংলা", "hello-bn"},
+		{"গোলাপ ফুল", "golap-phul"},
+	}
+
+	var wg sync.WaitGroup
+	for g := 0; g < 32; g++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for i := 0; i < 200; i++ {
+				tt := tests[i%len(tests)]
+				slug, err := s.Make(context.Background(), tt.input)
+				if err != nil {
+					t.Errorf("Make(%q) returned error: %v", tt.input, err)
+					return
+				}
+				if slug != tt.expected {
+					t.Errorf("Make(%q) = %q, expected %q", tt.input, slug, tt.expected)
+					return
+				}
+			}
+		}()
+	}
+	wg.Wait()
+}
+
+// TestMakeConcurrentUnique tests that parallel callers never receive the same slug.
+func TestMakeConcurrentUnique(t *testing.T) {
+	s := New(WithUseCache(true))
+	const total = 1000
+
+	var wg sync.WaitGroup
+	slugs := make(chan string, total)
+	for i := 0; i < total; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			slug, err := s.Make(context.Background(), "My Post")
+			if err != nil {
+				t.Errorf("Make returned error: %v", err)
+				return
+			}
+			slugs <- slug
+		}()
+	}
+	wg.Wait()
+	close(slugs)
+
+	seen := make(map[string]struct{}, total)
+	for slug := range slugs {
+		if _, dup := seen[slug]; dup {
+			t.Errorf("slug %q generated more than once", slug)
+		}
+		seen[slug] = struct{}{}
+	}
+	if len(seen) != total {
+		t.Errorf("got %d unique slugs, expected %d", len(seen), total)
+	}
+}
+
+// TestCacheStore tests the in-memory cache through the UniquenessStore interface.
+func TestCacheStore(t *testing.T) {
+	var store UniquenessStore = NewCache()
+	ctx := context.Background()
+
+	ok, err := store.Reserve(ctx, "slug1")
+	if err != nil || !ok {
+		t.Fatalf("Reserve('slug1') = %v, %v, expected true, nil", ok, err)
+	}
+	ok, err = store.Reserve(ctx, "slug1")
+	if err != nil || ok {
+		t.Errorf("second Reserve('slug1') = %v, %v, expected false, nil", ok, err)
+	}
+	if exists, _ := store.Exists(ctx, "slug1"); !exists {
+		t.Errorf("Exists('slug1') = false, expected true")
+	}
+	if err := store.Release(ctx, "slug1"); err != nil {
+		t.Errorf("Release('slug1') returned error: %v", err)
+	}
+	if exists, _ := store.Exists(ctx, "slug1"); exists {
+		t.Errorf("Exists('slug1') after release = true, expected false")
+	}
+}
+
+// takenStore is a UniquenessStore that starts with a fixed set of slugs taken.
+type takenStore struct {
+	mu    sync.Mutex
+	taken map[string]bool
+}
+
+func (s *takenStore) Reserve(_ context.Context, slug string) (bool, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.taken[slug] {
+		return false, nil
+	}
+	s.taken[slug] = true
+	return true, nil
+}
+
+func (s *takenStore) Exists(_ context.Context, slug string) (bool, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.taken[slug], nil
+}
+
+func (s *takenStore) Release(_ context.Context, slug string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	delete(s.taken, slug)
+	return nil
+}
+
+// TestMakeWithStore tests uniqueness against a custom UniquenessStore.
+func TestMakeWithStore(t *testing.T) {
+	store := &takenStore{taken: map[string]bool{"my-post": true, "my-post-1": true}}
+	s := New(WithUseCache(true), WithStore(store))
+
+	slug, err := s.Make(context.Background(), "My Post")
+	if err != nil {
+		t.Fatalf("Make returned error: %v", err)
+	}
+	if slug != "my-post-2" {
+		t.Errorf("Make = %q, expected %q", slug, "my-post-2")
+	}
+	if exists, _ := store.Exists(context.Background(), "my-post-2"); !exists {
+		t.Errorf("store does not contain %q after Make", "my-post-2")
+	}
+}
+
+// countingStore is a Cache that counts Reserve calls.
+type countingStore struct {
+	*Cache
+	reserves int
+}
+
+func (s *countingStore) Reserve(ctx context.Context, slug string) (bool, error) {
+	s.reserves++
+	return s.Cache.Reserve(ctx, slug)
+}
+
+// TestEnsureUniqueResumes tests that repeated duplicates do not re-probe taken suffixes.
+func TestEnsureUniqueResumes(t *testing.T) {
+	store := &countingStore{Cache: NewCache()}
+	s := New(WithUseCache(true), WithStore(store))
+
+	for i := 0; i < 50; i++ {
+		if _, err := s.Make(context.Background(), "My Post"); err != nil {
+			t.Fatalf("Make returned error: %v", err)
+		}
+	}
+	store.reserves = 0
+	slug, err := s.Make(context.Background(), "My Post")
+	if err != nil {
+		t.Fatalf("Make returned error: %v", err)
+	}
+	if slug != "my-post-50" {
+		t.Errorf("Make = %q, expected %q", slug, "my-post-50")
+	}
+	if store.reserves != 2 {
+		t.Errorf("Make called Reserve %d times, expected 2", store.reserves)
+	}
+}
+
+// TestEnsureUniqueAfterRelease tests that a released slug is handed out again.
+func TestEnsureUniqueAfterRelease(t *testing.T) {
+	store := NewCache()
+	s := New(WithUseCache(true), WithStore(store))
+	ctx := context.Background()
+
+	for _, want := range []string{"my-post", "my-post-1", "my-post-2"} {
+		if slug, err := s.Make(ctx, "My Post"); err != nil || slug != want {
+			t.Fatalf("Make = %q, %v, expected %q, nil", slug, err, want)
+		}
+	}
+	if err := store.Release(ctx, "my-post"); err != nil {
+		t.Fatalf("Release returned error: %v", err)
+	}
+	if slug, err := s.Make(ctx, "My Post"); err != nil || slug != "my-post" {
+		t.Errorf("Make after release = %q, %v, expected %q, nil", slug, err, "my-post")
+	}
+	if slug, err := s.Make(ctx, "My Post"); err != nil || slug != "my-post-3" {
+		t.Errorf("Make = %q, %v, expected %q, nil", slug, err, "my-post-3")
+	}
+}
+
+// TestEnsureUniqueTimestampTick tests that a new clock tick starts a fresh
+// timestamp suffix rather than numbering on from the previous one.
+func TestEnsureUniqueTimestampTick(t *testing.T) {
+	tick := time.Date(2023, 11, 14, 22, 14, 0, 0, time.UTC)
+	now = func() time.Time { return tick }
+	defer func() { now = time.Now }()
+
+	s := New(WithUseCache(true), WithSuffixStyle("timestamp"))
+	ctx := context.Background()
+	for _, want := range []string{"x", "x-20231114221400", "x-20231114221400-1"} {
+		if slug, err := s.Make(ctx, "x"); err != nil || slug != want {
+			t.Fatalf("Make = %q, %v, expected %q, nil", slug, err, want)
+		}
+	}
+	tick = tick.Add(time.Minute)
+	if slug, err := s.Make(ctx, "x"); err != nil || slug != "x-20231114221500" {
+		t.Errorf("Make after the clock moved = %q, %v, expected %q, nil", slug, err, "x-20231114221500")
+	}
+}
+
 // TestMakeWithMaxLength tests slug truncation.
 func TestMakeWithMaxLength(t *testing.T) {
 	s := New(WithMaxLength(5))
@@ -254,6 +587,111 @@ func TestMakeWithMaxLength(t *testing.T) {
 	}
 }
 
+// TestMakeWithMaxLengthAndSuffix tests that suffixes never push a slug past MaxLength.
+func TestMakeWithMaxLengthAndSuffix(t *testing.T) {
+	s := New(WithMaxLength(10), WithUseCache(true), WithSuffixStyle("version"))
+	expected := []string{"hello-wond", "hello-w-v1", "hello-w-v2"}
+	for _, want := range expected {
+		slug, err := s.Make(context.Background(), "Hello Wonderful World")
+		if err != nil {
+			t.Fatalf("Make returned error: %v", err)
+		}
+		if slug != want {
+			t.Errorf("Make = %q, expected %q", slug, want)
+		}
+	}
+
+	s = New(WithMaxLength(10), WithUseCache(true))
+	seen := make(map[string]struct{})
+	for i := 0; i < 150; i++ {
+		slug, err := s.Make(context.Background(), "Hello Wonderful World")
+		if err != nil {
+			t.Fatalf("Make returned error: %v", err)
+		}
+		if len(slug) > 10 {
+			t.Errorf("Make = %q has length %d, expected <= 10", slug, len(slug))
+		}
+		if _, dup := seen[slug]; dup {
+			t.Errorf("Make returned duplicate %q", slug)
+		}
+		seen[slug] = struct{}{}
+	}
+}
+
+// TestMakeWithLongSuffix tests suffixes longer than the room MaxLength leaves.
+func TestMakeWithLongSuffix(t *testing.T) {
+	tests := []struct {
+		name    string
+		options []Options
+		pattern string
+	}{
+		{"UUID", []Options{WithMaxLength(30), WithSuffixStyle("uuid")}, `^h-[0-9a-f]{28}$`},
+		{"ShortUUID", []Options{WithMaxLength(12), WithSuffixStyle("uuid")}, `^h-[0-9a-f]{10}$`},
+		{"Hash", []Options{WithMaxLength(10), WithSuffixStyle("hash"), WithSuffixLength(20)}, `^h-[0-9a-f]{8}$`},
+		{"ShortID", []Options{WithMaxLength(8), WithSuffixStyle("shortid"), WithSuffixLength(12)}, `^h-[0-9a-z]{6}$`},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s := New(append(tt.options, WithUseCache(true))...)
+			if _, err := s.Make(context.Background(), "Hello Wonderful World"); err != nil {
+				t.Fatalf("Make returned error: %v", err)
+			}
+			slug, err := s.Make(context.Background(), "Hello Wonderful World")
+			if err != nil {
+				t.Fatalf("Make returned error: %v", err)
+			}
+			if !regexp.MustCompile(tt.pattern).MatchString(slug) {
+				t.Errorf("Make = %q, expected match for %s", slug, tt.pattern)
+			}
+			if len(slug) > s.MaxLength {
+				t.Errorf("Make = %q has length %d, expected <= %d", slug, len(slug), s.MaxLength)
+			}
+		})
+	}
+
+	// No room for a numeric suffix after one rune of the slug
+	s := New(WithMaxLength(2), WithUseCache(true))
+	if slug, err := s.Make(context.Background(), "Hello"); err != nil || slug != "he" {
+		t.Fatalf("Make = %q, %v, expected %q, nil", slug, err, "he")
+	}
+	if slug, err := s.Make(context.Background(), "Hello"); err != ErrSuffixTooLong {
+		t.Errorf("Make = %q, %v, expected error %v", slug, err, ErrSuffixTooLong)
+	}
+}
+
+// TestMakeWithWordTruncation tests word-boundary-aware truncation.
+func TestMakeWithWordTruncation(t *testing.T) {
+	tests := []struct {
+		name     string
+		options  []Options
+		input    string
+		expected string
+	}{
+		{"LastSeparator", []Options{WithMaxLength(18)}, "The quick brown fox jumps", "the-quick-brown"},
+		{"ExactBoundary", []Options{WithMaxLength(15)}, "The quick brown fox jumps", "the-quick-brown"},
+		{"TrailingSeparator", []Options{WithMaxLength(10)}, "The quick brown fox", "the-quick"},
+		{"LongWord", []Options{WithMaxLength(8)}, "Supercalifragilistic day", "supercal"},
+		{"Fits", []Options{WithMaxLength(50)}, "The quick brown fox", "the-quick-brown-fox"},
+		{"StopWords", []Options{WithMaxLength(22), WithTruncateStopWords("en")}, "The story of the quick brown fox", "story-quick-brown-fox"},
+		{"StopWordsNotEnough", []Options{WithMaxLength(12), WithTruncateStopWords("en")}, "The story of the quick brown fox", "story-quick"},
+		{"CustomDelimiter", []Options{WithMaxLength(18), WithPipeline(Lowercase(), ReplaceSpaces("_"))}, "The quick brown fox jumps", "the_quick_brown"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s := New(append([]Options{WithTruncation("word")}, tt.options...)...)
+			slug, err := s.Make(context.Background(), tt.input)
+			if err != nil {
+				t.Errorf("Make(%q) returned error: %v", tt.input, err)
+			}
+			if slug != tt.expected {
+				t.Errorf("Make(%q) = %q, expected %q", tt.input, slug, tt.expected)
+			}
+		})
+	}
+}
+
 // TestMakeWithContextCancel tests context cancellation.
 func TestMakeWithContextCancel(t *testing.T) {
 	ctx, cancel := context.WithCancel(context.Background())
@@ -275,9 +713,27 @@ func TestMakeBangla(t *testing.T) {
 	}{
 		{"বাংলা", "bangla"},
 		{"আমি ভালো", "ami-bhalo"},
-		{"ক্ত", "kt"},
+		{"ক্ত", "kto"},
 		{"Hello বাংলা", "hello-bangla"},
 		{"ষ্ঠান", "shthan"},
+		{"কলকাতা", "kolkata"},
+		{"আমরা", "amra"},
+		{"জনগণ", "jonogon"},
+		{"সম্পর্ক", "somporko"},
+		{"ধর্ম", "dhormo"},
+		{"নির্বাচন", "nirbachon"},
+		{"উৎসব", "utsob"},
+		{"দুঃখ", "dukkho"},
+		{"চাঁদ", "chad"},
+		{"রং", "rong"},
+		{"শিক্ষা", "shikkha"},
+		{"জ্ঞান", "gyan"},
+		{"বাক্", "bak"},
+		{"স\u09a1\u09bcক", "sorok"},
+		{"প্রি\u09af\u09bc", "priyo"},
+		{"ভা\u09b2\u09c7\u09be", "bhalo"},
+		{"২০২৪ সাল", "2024-sal"},
+		{"বাংলা। ভাষা", "bangla-bhasha"},
 	}
 
 	for _, tt := range tests {
@@ -293,6 +749,622 @@ func TestMakeBangla(t *testing.T) {
 	}
 }
 
+// TestMakeRussian tests the Russian schemes on mixed-case input.
+func TestMakeRussian(t *testing.T) {
+	tests := []struct {
+		scheme   string
+		input    string
+		expected string
+	}{
+		{"simple", "Привет Мир", "privet-mir"},
+		{"simple", "МОСКВА 2024", "moskva-2024"},
+		{"simple", "Щука и Ёж", "shchuka-i-yozh"},
+		{"gost", "Цирк Царицын", "cirk-czaricyn"},
+		{"gost", "Щёлково Хабаровск", "shhyolkovo-xabarovsk"},
+		{"gost", "Йошкар-Ола", "joshkar-ola"},
+		{"iso9", "Жуков Щёкино", "zukov-sekino"},
+		{"iso9", "Чебоксары Юрьев", "ceboksary-urev"},
+		{"iso9", "Щука Ёж Хрущёв", "suka-ez-hrusev"},
+		{"bgn", "Елена Алексеевна", "yelena-alekseyevna"},
+		{"bgn", "Подъезд Ёлкино", "podyezd-yelkino"},
+		{"bgn", "Хабаровск", "khabarovsk"},
+		{"unknown", "Привет", "privet"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.scheme+"/"+tt.input, func(t *testing.T) {
+			s := New(WithLanguage("ru"), WithRussianScheme(tt.scheme))
+			slug, err := s.Make(context.Background(), tt.input)
+			if err != nil {
+				t.Errorf("Make(%q) returned error: %v", tt.input, err)
+			}
+			if slug != tt.expected {
+				t.Errorf("Make(%q) = %q, expected %q", tt.input, slug, tt.expected)
+			}
+		})
+	}
+}
+
+// TestMakeBanglaHeadlines tests the Banglish rules against news headlines.
+func TestMakeBanglaHeadlines(t *testing.T) {
+	s := New(WithLanguage("bn"))
+	tests := []struct {
+		input    string
+		expected string
+	}{
+		{"প্রধানমন্ত্রী শেখ হাসিনার সঙ্গে বৈঠক", "prodhanmontree-shekh-hasinar-songe-boithok"},
+		{"ঢাকায় বৃষ্টিতে জলাবদ্ধতা", "dhakay-brishtite-jolaboddhota"},
+		{"চট্টগ্রামে সড়ক দুর্ঘটনায় নিহত ৫", "chottograme-sorok-durghotonay-nihot-5"},
+		{"বাংলাদেশ ক্রিকেট দলের বিশ্বকাপ জয়", "bangladesh-kriket-doler-bishwokap-joy"},
+		{"নির্বাচন কমিশনের নতুন সিদ্ধান্ত", "nirbachon-komishner-notun-siddhanto"},
+		{"সংসদে বাজেট পাস", "songsode-bajet-pas"},
+		{"মুক্তিযুদ্ধের ইতিহাস", "muktijuddher-itihas"},
+		{"শিক্ষা মন্ত্রণালয়ের পরীক্ষা সূচি প্রকাশ", "shikkha-montronaloyer-poreekkha-soochi-prokash"},
+		{"কলকাতায় দুর্গাপূজা উৎসব", "kolkatay-durgapooja-utsob"},
+		{"বিশ্ববিদ্যালয়ে ভর্তি পরীক্ষা শুরু", "bishwobidyaloye-bhorti-poreekkha-shuru"},
+		{"দুঃখজনক ঘটনা", "dukkhojonok-ghotna"},
+		{"সম্পর্ক উন্নয়নে আলোচনা", "somporko-unnoyone-alochna"},
+		{"জনগণের অধিকার", "jonogoner-odhikar"},
+		{"বঙ্গবন্ধু সেতু", "bongobondhu-setu"},
+		{"বিজ্ঞান ও প্রযুক্তি", "biggan-o-projukti"},
+		{"সরকারি চাকরিতে নিয়োগ", "sorkari-chakrite-niyog"},
+		{"পরিকল্পনা মন্ত্রী", "porikolpona-montree"},
+		{"উপজেলা পরিষদ নির্বাচন", "upjela-porishod-nirbachon"},
+		{"মহাসড়কে যানজট", "mohasoroke-janojot"},
+		{"রবীন্দ্রনাথ ঠাকুর", "robeendronath-thakur"},
+		{"রোহিঙ্গা শরণার্থী", "rohinga-shornarthee"},
+		{"স্বাস্থ্য অধিদপ্তর", "swasthyo-odhidoptor"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.input, func(t *testing.T) {
+			slug, err := s.Make(context.Background(), tt.input)
+			if err != nil {
+				t.Errorf("Make(%q) returned error: %v", tt.input, err)
+			}
+			if slug != tt.expected {
+				t.Errorf("Make(%q) = %q, expected %q", tt.input, slug, tt.expected)
+			}
+		})
+	}
+}
+
+// TestMakeWithScheme tests selecting romanization schemes by name.
+func TestMakeWithScheme(t *testing.T) {
+	tests := []struct {
+		scheme   string
+		language string
+		input    string
+		expected string
+	}{
+		{"ru:translit", "ru", "Щука Хабаровск", "schuka-habarovsk"},
+		{"gost", "ru", "Щука Хабаровск", "shhuka-xabarovsk"},
+		{"ru:iso9", "ru", "Щука Хабаровск", "suka-habarovsk"},
+		{"ru:unknown", "ru", "Щука Хабаровск", "shchuka-khabarovsk"},
+		{"ar:arabizi", "ru", "Щука Хабаровск", "shchuka-khabarovsk"},
+		{"bn:banglish", "bn", "প্রি\u09df বাংলা", "priyo-bangla"},
+		{"bn:iast", "bn", "প্রি\u09df বাংলা", "priya-bamla"},
+		{"bn:iast", "bn", "সম্পর্ক", "samparka"},
+		{"bn:iast", "bn", "আমার সোনার বাংলা", "amara-sonara-bamla"},
+		{"gost", "bn", "প্রি\u09df বাংলা", "priyo-bangla"},
+		{"ar:arabizi", "ar", "حبيبي", "7bibi"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.scheme+"/"+tt.input, func(t *testing.T) {
+			s := New(WithLanguage(tt.language), WithScheme(tt.scheme))
+			slug, err := s.Make(context.Background(), tt.input)
+			if err != nil {
+				t.Errorf("Make(%q) returned error: %v", tt.input, err)
+			}
+			if slug != tt.expected {
+				t.Errorf("Make(%q) = %q, expected %q", tt.input, slug, tt.expected)
+			}
+		})
+	}
+}
+
+// TestRegisterTransliterator tests plugging in custom transliterators and
+// schemes.
+func TestRegisterTransliterator(t *testing.T) {
+	sinhala := map[rune]string{'ශ': "sh", 'ල': "la", 'ං': "n", 'ක': "ka", 'ා': "a"}
+	RegisterTransliterator("x-si", TransliteratorFunc(func(input string, b *strings.Builder) {
+		for _, r := range input {
+			if mapped, ok := sinhala[r]; ok {
+				b.WriteString(mapped)
+			} else {
+				b.WriteRune(r)
+			}
+		}
+	}))
+	RegisterTransliterator("ru:x-prefixed", TransliteratorFunc(func(input string, b *strings.Builder) {
+		b.WriteString("ru ")
+		TransliterateRussian(input, b)
+	}))
+
+	tests := []struct {
+		options  []Options
+		input    string
+		expected string
+	}{
+		{[]Options{WithLanguage("x-si")}, "ලංකා ශාක", "lankaa-shaka"},
+		{[]Options{WithLanguage("ru"), WithScheme("ru:x-prefixed")}, "Привет", "ru-privet"},
+		{[]Options{WithLanguage("ru")}, "Привет", "privet"},
+		{[]Options{WithLanguage("x-unregistered")}, "Hello World", "hello-world"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.input, func(t *testing.T) {
+			s := New(tt.options...)
+			slug, err := s.Make(context.Background(), tt.input)
+			if err != nil {
+				t.Errorf("Make(%q) returned error: %v", tt.input, err)
+			}
+			if slug != tt.expected {
+				t.Errorf("Make(%q) = %q, expected %q", tt.input, slug, tt.expected)
+			}
+		})
+	}
+}
+
+// TestTransliterateCyrillicCase tests that Cyrillic transliteration keeps the
+// case of the input.
+func TestTransliterateCyrillicCase(t *testing.T) {
+	tests := []struct {
+		language string
+		scheme   string
+		input    string
+		expected string
+	}{
+		{"ru", "", "Привет Мир", "Privet Mir"},
+		{"ru", "", "ЩИ и Щука", "SHCHI i Shchuka"},
+		{"ru", "", "СССР", "SSSR"},
+		{"ru", "", "Я", "Ya"},
+		{"ru", "iso9", "Жуков ЖКХ", "Zukov ZKH"},
+		{"ru", "gost", "ЦИК Цирк", "CIK Cirk"},
+		{"uk", "", "Єнакієве ЄВРО", "Yenakiieve YEVRO"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.language+"/"+tt.input, func(t *testing.T) {
+			s := New(WithLanguage(tt.language), WithRussianScheme(tt.scheme))
+			got, err := s.Transliterate(tt.input)
+			if err != nil {
+				t.Errorf("Transliterate(%q) returned error: %v", tt.input, err)
+			}
+			if got != tt.expected {
+				t.Errorf("Transliterate(%q) = %q, expected %q", tt.input, got, tt.expected)
+			}
+		})
+	}
+}
+
+// TestMakeCyrillicVariants tests the national romanizations of Cyrillic alphabets.
+func TestMakeCyrillicVariants(t *testing.T) {
+	tests := []struct {
+		language string
+		input    string
+		expected string
+	}{
+		{"uk", "Київ", "kyiv"},
+		{"uk", "Згорани Розгон", "zghorany-rozghon"},
+		{"uk", "Єнакієве", "yenakiieve"},
+		{"uk", "Гаєвич", "haievych"},
+		{"uk", "Короп'є", "koropie"},
+		{"uk", "Йосипівка Стрий", "yosypivka-stryi"},
+		{"uk", "Юрій Олексій", "yurii-oleksii"},
+		{"uk", "Знам’янка", "znamianka"},
+		{"uk", "Їжакевич Кадиївка", "yizhakevych-kadyivka"},
+		{"uk", "Щербухи Гоща", "shcherbukhy-hoshcha"},
+		{"be", "Беларусь", "bielarus"},
+		{"be", "Віцебск Магілёў", "vitsiebsk-mahiliow"},
+		{"be", "Ян Ёлка", "yan-yolka"},
+		{"sr", "Београд", "beograd"},
+		{"sr", "Ђоковић", "djokovic"},
+		{"sr", "Његош Џеп", "njegos-dzep"},
+		{"bg", "София", "sofia"},
+		{"bg", "България", "balgaria"},
+		{"bg", "Щастие", "shtastie"},
+		{"bg", "Кюстендил", "kyustendil"},
+		{"kk", "Қазақстан", "qazaqstan"},
+		{"kk", "Өскемен Шымкент", "oskemen-shymkent"},
+		{"kk", "Әуезов", "auezov"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.language+"/"+tt.input, func(t *testing.T) {
+			s := New(WithLanguage(tt.language))
+			slug, err := s.Make(context.Background(), tt.input)
+			if err != nil {
+				t.Errorf("Make(%q) returned error: %v", tt.input, err)
+			}
+			if slug != tt.expected {
+				t.Errorf("Make(%q) = %q, expected %q", tt.input, slug, tt.expected)
+			}
+		})
+	}
+}
+
+// TestMakeLatin tests the per-language Latin folding tables.
+func TestMakeLatin(t *testing.T) {
+	tests := []struct {
+		lang     string
+		input    string
+		expected string
+	}{
+		{"de", "Größe Straße", "groesse-strasse"},
+		{"de", "Café Müller", "cafe-mueller"},
+		{"da", "Ærø Øresund Århus", "aeroe-oeresund-aarhus"},
+		{"no", "Blåbær", "blaabaer"},
+		{"sv", "Malmö Åsa", "malmo-asa"},
+		{"is", "Þingvellir Ísafjörður", "thingvellir-isafjordur"},
+		{"tr", "Işık İstanbul Şişli", "isik-istanbul-sisli"},
+		{"pl", "Łódź Gdańsk", "lodz-gdansk"},
+		{"vi", "Đà Nẵng Hồ Chí Minh", "da-nang-ho-chi-minh"},
+		{"hr", "Đakovo", "djakovo"},
+		{"fr", "Œuvre cœur", "oeuvre-coeur"},
+		{"ca", "Col·legi", "collegi"},
+		{"cs", "Příliš žluťoučký kůň", "prilis-zlutoucky-kun"},
+		{"ro", "Țară Şcoală", "tara-scoala"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.lang+"/"+tt.input, func(t *testing.T) {
+			s := New(WithLanguage(tt.lang))
+			slug, err := s.Make(context.Background(), tt.input)
+			if err != nil {
+				t.Errorf("Make(%q) returned error: %v", tt.input, err)
+			}
+			if slug != tt.expected {
+				t.Errorf("Make(%q) = %q, expected %q", tt.input, slug, tt.expected)
+			}
+		})
+	}
+
+	got, err := New(WithLanguage("de")).Transliterate("ÄRGER über Öl")
+	if err != nil || got != "AERGER ueber Oel" {
+		t.Errorf("Transliterate(%q) = %q, %v, expected %q", "ÄRGER über Öl", got, err, "AERGER ueber Oel")
+	}
+}
+
+// TestMakeGreek tests Greek transliteration following ELOT 743.
+func TestMakeGreek(t *testing.T) {
+	s := New(WithLanguage("el"))
+	tests := []struct {
+		input    string
+		expected string
+	}{
+		{"Αθήνα", "athina"},
+		{"Ευχαριστώ", "efcharisto"},
+		{"Αύριο", "avrio"},
+		{"Ευαγγέλιο", "evangelio"},
+		{"Ουρανός", "ouranos"},
+		{"Μπαμπάς", "bampas"},
+		{"Ντομάτα πέντε", "domata-pente"},
+		{"Γκρίζος άγκυρα", "grizos-agkyra"},
+		{"Σφίγξ", "sfinx"},
+		{"Ψυχή", "psychi"},
+		{"Πρωτεΐνη", "proteini"},
+		{"Ταΰγετος", "taygetos"},
+		{"Οι ειδήσεις της ημέρας", "oi-eidiseis-tis-imeras"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.input, func(t *testing.T) {
+			slug, err := s.Make(context.Background(), tt.input)
+			if err != nil {
+				t.Errorf("Make(%q) returned error: %v", tt.input, err)
+			}
+			if slug != tt.expected {
+				t.Errorf("Make(%q) = %q, expected %q", tt.input, slug, tt.expected)
+			}
+		})
+	}
+}
+
+// TestMakeArabic tests Arabic transliteration.
+func TestMakeArabic(t *testing.T) {
+	tests := []struct {
+		scheme   string
+		input    string
+		expected string
+	}{
+		{"simple", "الشمس", "ash-shms"},
+		{"simple", "الشَّمْس", "ash-shms"},
+		{"simple", "القمر", "al-qmr"},
+		{"simple", "مُحَمَّد", "mhmmd"},
+		{"simple", "كتـــاب", "ktab"},
+		{"simple", "بيروت", "birut"},
+		{"simple", "يوم", "yum"},
+		{"simple", "أخبار ٢٠٢٤", "akhbar-2024"},
+		{"simple", "دبي، الإمارات", "dbi-al-imarat"},
+		{"arabizi", "حبيبي", "7bibi"},
+		{"arabizi", "عمر", "3mr"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.scheme+"/"+tt.input, func(t *testing.T) {
+			s := New(WithLanguage("ar"), WithArabicScheme(tt.scheme))
+			slug, err := s.Make(context.Background(), tt.input)
+			if err != nil {
+				t.Errorf("Make(%q) returned error: %v", tt.input, err)
+			}
+			if slug != tt.expected {
+				t.Errorf("Make(%q) = %q, expected %q", tt.input, slug, tt.expected)
+			}
+		})
+	}
+}
+
+// TestMakeHebrew tests Hebrew and Yiddish transliteration.
+func TestMakeHebrew(t *testing.T) {
+	tests := []struct {
+		lang     string
+		input    string
+		expected string
+	}{
+		{"he", "שָׁלוֹם עוֹלָם", "shalom-olam"},
+		{"he", "בְּרֵאשִׁית בָּרָא אֱלֹהִים", "bereshit-bara-elohim"},
+		{"he", "יְרוּשָׁלַיִם", "yerushalayim"},
+		{"he", "רוּחַ", "ruah"},
+		{"he", "דָּוִד", "david"},
+		{"he", "תל אביב", "tl-aviv"},
+		{"he", "תורה", "tora"},
+		{"he", "ג׳ירפה", "jirfa"},
+		{"he", "צ'יפס", "chifs"},
+		{"he", "צה״ל", "tshl"},
+		{"he", "\u200fחדשות\u200e \u202bהיום\u202c", "hdshot-hiom"},
+		{"he", "שׂרה", "sra"},
+		{"yi", "ייִדיש", "yidish"},
+		{"yi", "אַ גוטן טאָג", "a-gutn-tog"},
+		{"yi", "װאָס מאַכסטו", "vos-makhstu"},
+		{"yi", "פֿרײַנד זשורנאַל", "fraynd-zhurnal"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.lang+"/"+tt.input, func(t *testing.T) {
+			s := New(WithLanguage(tt.lang))
+			slug, err := s.Make(context.Background(), tt.input)
+			if err != nil {
+				t.Errorf("Make(%q) returned error: %v", tt.input, err)
+			}
+			if slug != tt.expected {
+				t.Errorf("Make(%q) = %q, expected %q", tt.input, slug, tt.expected)
+			}
+		})
+	}
+}
+
+// TestMakeDevanagari tests Hindi, Marathi and Nepali transliteration.
+func TestMakeDevanagari(t *testing.T) {
+	tests := []struct {
+		language string
+		input    string
+		expected string
+	}{
+		{"hi", "भारत की खबर", "bharat-ki-khabar"},
+		{"hi", "नमस्ते", "namaste"},
+		{"hi", "मित्र", "mitra"},
+		{"hi", "हिंदी", "hindi"},
+		{"hi", "संबंध", "sambandh"},
+		{"hi", "ज्ञान", "gyan"},
+		{"hi", "क़िला", "qila"},
+		{"hi", "ज़िंदगी", "zindagi"},
+		{"hi", "\u095bमीन", "zamin"},
+		{"hi", "क्षमा", "kshama"},
+		{"hi", "न", "na"},
+		{"hi", "दुःख", "duhkh"},
+		{"hi", "बजट २०२४।", "bajat-2024"},
+		{"mr", "महाराष्ट्र", "maharashtra"},
+		{"ne", "नेपाल", "nepal"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.language+"/"+tt.input, func(t *testing.T) {
+			s := New(WithLanguage(tt.language))
+			slug, err := s.Make(context.Background(), tt.input)
+			if err != nil {
+				t.Errorf("Make(%q) returned error: %v", tt.input, err)
+			}
+			if slug != tt.expected {
+				t.Errorf("Make(%q) = %q, expected %q", tt.input, slug, tt.expected)
+			}
+		})
+	}
+}
+
+// TestMakeChinese tests Hanzi to Pinyin transliteration.
+func TestMakeChinese(t *testing.T) {
+	s := New(WithLanguage("zh"))
+	tests := []struct {
+		input    string
+		expected string
+	}{
+		{"北京欢迎你", "bei-jing-huan-ying-ni"},
+		{"中華民國", "zhong-hua-min-guo"},
+		{"长城", "chang-cheng"},
+		{"我的银行", "wo-de-yin-hang"},
+		{"重庆火锅", "chong-qing-huo-guo"},
+		{"重要新闻", "zhong-yao-xin-wen"},
+		{"绿色", "lyu-se"},
+		{"iPhone手机，2024年", "iphone-shou-ji-2024-nian"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.input, func(t *testing.T) {
+			slug, err := s.Make(context.Background(), tt.input)
+			if err != nil {
+				t.Errorf("Make(%q) returned error: %v", tt.input, err)
+			}
+			if slug != tt.expected {
+				t.Errorf("Make(%q) = %q, expected %q", tt.input, slug, tt.expected)
+			}
+		})
+	}
+}
+
+// TestMakeJapanese tests Hepburn romanization of kana and the kanji reader hook.
+func TestMakeJapanese(t *testing.T) {
+	reader := KanjiDict{"日本": "にほん", "東京": "とうきょう", "東": "ひがし"}
+	tests := []struct {
+		input    string
+		expected string
+	}{
+		{"とうきょう", "tokyo"},
+		{"おおさか", "osaka"},
+		{"コーヒー", "kohi"},
+		{"きって", "kitte"},
+		{"マッチ", "matchi"},
+		{"ベッド", "beddo"},
+		{"しんぶん", "shinbun"},
+		{"きんえん", "kin-en"},
+		{"じゃんけん", "janken"},
+		{"ファイル", "fairu"},
+		{"ありがとうございます", "arigatogozaimasu"},
+		{"すし・ラーメン", "sushi-ramen"},
+		{"日本のカタログ", "nihonno-katarogu"},
+		{"東京タワー", "tokyo-tawa"},
+		{"東口", "higashi"},
+	}
+
+	s := New(WithLanguage("ja"), WithKanjiReader(reader))
+	for _, tt := range tests {
+		t.Run(tt.input, func(t *testing.T) {
+			slug, err := s.Make(context.Background(), tt.input)
+			if err != nil {
+				t.Errorf("Make(%q) returned error: %v", tt.input, err)
+			}
+			if slug != tt.expected {
+				t.Errorf("Make(%q) = %q, expected %q", tt.input, slug, tt.expected)
+			}
+		})
+	}
+}
+
+// TestMakeKorean tests Revised Romanization of Hangul.
+func TestMakeKorean(t *testing.T) {
+	s := New(WithLanguage("ko"))
+	tests := []struct {
+		input    string
+		expected string
+	}{
+		{"안녕하세요", "annyeonghaseyo"},
+		{"한국어", "hangugeo"},
+		{"대한민국", "daehanminguk"},
+		{"부산", "busan"},
+		{"국물", "gungmul"},
+		{"합니다", "hamnida"},
+		{"신라", "silla"},
+		{"설날", "seollal"},
+		{"종로", "jongno"},
+		{"백리", "baengni"},
+		{"좋다", "jota"},
+		{"않고", "anko"},
+		{"싫다", "silta"},
+		{"읽어요", "ilgeoyo"},
+		{"같이", "gachi"},
+		{"굳이", "guji"},
+		{"해돋이", "haedoji"},
+		{"굳히다", "guchida"},
+		{"핥이다", "halchida"},
+		{"밭에", "bate"},
+		{"서울 특별시", "seoul-teukbyeolsi"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.input, func(t *testing.T) {
+			slug, err := s.Make(context.Background(), tt.input)
+			if err != nil {
+				t.Errorf("Make(%q) returned error: %v", tt.input, err)
+			}
+			if slug != tt.expected {
+				t.Errorf("Make(%q) = %q, expected %q", tt.input, slug, tt.expected)
+			}
+		})
+	}
+}
+
+// TestMakeSoutheastAsian tests Thai, Lao, Khmer and Burmese segmentation and romanization.
+func TestMakeSoutheastAsian(t *testing.T) {
+	tests := []struct {
+		lang     string
+		input    string
+		expected string
+	}{
+		{"th", "ข่าวประเทศไทยวันนี้", "khao-prathet-thai-wan-ni"},
+		{"th", "สวัสดีชาวโลก", "sawatdi-chao-lok"},
+		{"th", "นายกรัฐมนตรีเดินทางไปเชียงใหม่", "nayokratthamontri-doenthang-pai-chiang-mai"},
+		{"th", "ราคาน้ำมันเพิ่มขึ้น ๕ บาท", "rakha-namman-phoem-khuen-5-bat"},
+		{"th", "เด็กๆ ไปโรงเรียน", "dek-dek-pai-rongrian"},
+		{"th", "หนัก", "nak"},
+		{"th", "หมา", "ma"},
+		{"th", "อยาก", "yak"},
+		{"th", "ฝนตกหนัก", "fon-tok-nak"},
+		{"th", "เหมือน", "muean"},
+		{"lo", "ຂ່າວປະເທດລາວມື້ນີ້", "khao-pathet-lao-muni"},
+		{"lo", "ສະບາຍດີ ຫຼວງພະບາງ", "sabaidi-louangphabang"},
+		{"lo", "ລາຄານ້ຳມັນ ໕ ກີບ", "lakha-namman-5-kip"},
+		{"km", "ព័ត៌មានកម្ពុជាថ្ងៃនេះ", "pordamean-kampuchea-thngai-nih"},
+		{"km", "ភ្នំពេញ សៀមរាប", "phnom-penh-siem-reab"},
+		{"km", "នាយករដ្ឋមន្ត្រីទៅប្រទេសចិន", "neayokrodthamontrei-tov-prates-chen"},
+		{"my", "မြန်မာနိုင်ငံသတင်း", "myanma-naingngan-thatin"},
+		{"my", "ရန်ကုန် မန္တလေး", "yangon-mandalay"},
+		{"my", "ဆန်ဈေး ၅ ကျပ်", "hsan-zay-5-kyat"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.lang+"/"+tt.input, func(t *testing.T) {
+			s := New(WithLanguage(tt.lang))
+			slug, err := s.Make(context.Background(), tt.input)
+			if err != nil {
+				t.Errorf("Make(%q) returned error: %v", tt.input, err)
+			}
+			if slug != tt.expected {
+				t.Errorf("Make(%q) = %q, expected %q", tt.input, slug, tt.expected)
+			}
+		})
+	}
+}
+
+// TestMakeAuto tests auto language mode on mixed-script input.
+func TestMakeAuto(t *testing.T) {
+	reader := KanjiDict{"東京": "とうきょう"}
+	tests := []struct {
+		input    string
+		opts     []Options
+		expected string
+	}{
+		{"বাংলা News Москва 2024", nil, "bangla-news-moskva-2024"},
+		{"Привет World", nil, "privet-world"},
+		{"Щука News", []Options{WithScheme("ru:gost")}, "shhuka-news"},
+		{"北京 Olympics", nil, "bei-jing-olympics"},
+		{"東京タワー", []Options{WithKanjiReader(reader)}, "tokyo-tawa"},
+		{"東京タワー 中国", []Options{WithKanjiReader(reader)}, "tokyo-tawa-zhong-guo"},
+		{"大阪タワー", []Options{WithKanjiReader(reader)}, "da-ban-tawa"},
+		{"東京タワー", nil, "dong-jing-tawa"},
+		{"ニュース 中国", nil, "nyusu-zhong-guo"},
+		{"東京タワー 中国", nil, "dong-jing-tawa-zhong-guo"},
+		{"Café Ελλάδα", nil, "cafe-ellada"},
+		{"สวัสดี Bangkok", nil, "sawatdi-bangkok"},
+		{"서울Seoul", nil, "seoul-seoul"},
+		{"नमस्ते India", nil, "namaste-india"},
+		{"12345", nil, "12345"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.input, func(t *testing.T) {
+			s := New(append([]Options{WithLanguage("auto")}, tt.opts...)...)
+			slug, err := s.Make(context.Background(), tt.input)
+			if err != nil {
+				t.Errorf("Make(%q) returned error: %v", tt.input, err)
+			}
+			if slug != tt.expected {
+				t.Errorf("Make(%q) = %q, expected %q", tt.input, slug, tt.expected)
+			}
+		})
+	}
+}
+
 // TestRegexFilter tests regex filter functionality.
 func TestRegexFilter(t *testing.T) {
 	s := New(
@@ -377,6 +1449,42 @@ func TestAbbreviations(t *testing.T) {
 	}
 }
 
+// TestAbbreviationOrder tests that overlapping abbreviations resolve the same way every run.
+func TestAbbreviationOrder(t *testing.T) {
+	tests := []struct {
+		name     string
+		options  []Options
+		input    string
+		expected string
+	}{
+		{"LongestFirst", []Options{WithAbbreviation("New York", "NY"), WithAbbreviation("New York City", "NYC")}, "New York City Marathon", "nyc-marathon"},
+		{"LongestFirstReversed", []Options{WithAbbreviation("New York City", "NYC"), WithAbbreviation("New York", "NY")}, "New York City and New York", "nyc-and-ny"},
+		{"Priority", []Options{WithAbbreviation("New York City", "NYC"), WithAbbreviationPriority("York City", "YC", 5)}, "New York City", "new-yc"},
+		{"Overlapping", []Options{WithAbbreviation("he", "x"), WithAbbreviation("she", "y"), WithAbbreviation("hers", "z")}, "ushers she", "usz-y"},
+		{"Chained", []Options{WithAbbreviation("a", "b"), WithAbbreviation("b", "c")}, "a b", "b-c"},
+		{"Replaced", []Options{WithAbbreviation("Go", "golang"), WithAbbreviation("Go", "gopher")}, "Go", "gopher"},
+		{"Substring", []Options{WithAbbreviation("cat", "feline")}, "concatenate cat", "confelineenate-feline"},
+		{"WordBoundary", []Options{WithAbbreviation("cat", "feline"), WithAbbreviationWordBoundary(true)}, "concatenate cat", "concatenate-feline"},
+		{"CaseSensitive", []Options{WithAbbreviation("javascript", "js")}, "JavaScript javascript", "javascript-js"},
+		{"IgnoreCase", []Options{WithAbbreviation("javascript", "js"), WithAbbreviationIgnoreCase(true)}, "JavaScript javascript", "js-js"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			for i := 0; i < 20; i++ {
+				s := New(tt.options...)
+				slug, err := s.Make(context.Background(), tt.input)
+				if err != nil {
+					t.Fatalf("Make(%q) returned error: %v", tt.input, err)
+				}
+				if slug != tt.expected {
+					t.Fatalf("Make(%q) = %q, expected %q", tt.input, slug, tt.expected)
+				}
+			}
+		})
+	}
+}
+
 // BenchmarkTransliterateBangla measures Bangla transliteration performance.
 func BenchmarkTransliterateBangla(b *testing.B) {
 	s := New(WithLanguage("bn"))
@@ -0,0 +1,10 @@
+module github.com/mnuddindev/slugcraft/sqlstore
+
+go 1.24.0
+
+require (
+	github.com/mattn/go-sqlite3 v1.14.33
+	github.com/mnuddindev/slugcraft v0.0.0-20261017044103-a25a6c2e32bd
+)
+
+require golang.org/x/text v0.23.0 // indirect
@@ -0,0 +1,152 @@
+// Package sqlstore provides a slugcraft.UniquenessStore backed by a
+// database/sql table, so that several application instances sharing one
+// database never hand out the same slug.
+package sqlstore
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"regexp"
+	"strconv"
+
+	slugcraft "github.com/mnuddindev/slugcraft"
+)
+
+// validTable matches the table names accepted by WithTable.
+var validTable = regexp.MustCompile(`^[A-Za-z_][A-Za-z0-9_]*(\.[A-Za-z_][A-Za-z0-9_]*)?$`)
+
+// Store reserves slugs in a table with a unique constraint on the slug column.
+type Store struct {
+	db          *sql.DB
+	table       string
+	placeholder func(n int) string
+	retries     int
+}
+
+var _ slugcraft.UniquenessStore = (*Store)(nil)
+
+// Option defines a functional option for configuring Store.
+type Option func(*Store)
+
+// WithTable sets the table used to record slugs (default: "slugs").
+func WithTable(name string) Option {
+	return func(s *Store) {
+		s.table = name
+	}
+}
+
+// WithDollarPlaceholders switches queries to $1-style placeholders, as used by
+// PostgreSQL drivers. The default is "?".
+func WithDollarPlaceholders() Option {
+	return func(s *Store) {
+		s.placeholder = func(n int) string { return "$" + strconv.Itoa(n) }
+	}
+}
+
+// WithRetries sets how many times a reservation is retried after a
+// transaction fails for a reason other than the slug being taken (default: 3).
+func WithRetries(n int) Option {
+	return func(s *Store) {
+		if n >= 0 {
+			s.retries = n
+		}
+	}
+}
+
+// New creates a Store on top of db. The table must exist; see CreateTable.
+func New(db *sql.DB, opts ...Option) (*Store, error) {
+	if db == nil {
+		return nil, errors.New("sqlstore: nil *sql.DB")
+	}
+	s := &Store{
+		db:          db,
+		table:       "slugs",
+		placeholder: func(int) string { return "?" },
+		retries:     3,
+	}
+	for _, opt := range opts {
+		opt(s)
+	}
+	if !validTable.MatchString(s.table) {
+		return nil, fmt.Errorf("sqlstore: invalid table name %q", s.table)
+	}
+	return s, nil
+}
+
+// CreateTable creates the slug table if it does not exist yet.
+func (s *Store) CreateTable(ctx context.Context) error {
+	_, err := s.db.ExecContext(ctx, "CREATE TABLE IF NOT EXISTS "+s.table+" (slug VARCHAR(255) NOT NULL PRIMARY KEY)")
+	return err
+}
+
+// Reserve checks for slug and inserts it inside a single transaction. If the
+// insert loses a race against another writer, the unique constraint rejects
+// it and Reserve reports the slug as taken.
+func (s *Store) Reserve(ctx context.Context, slug string) (bool, error) {
+	var lastErr error
+	for attempt := 0; attempt <= s.retries; attempt++ {
+		ok, err := s.reserve(ctx, slug)
+		if err == nil {
+			return ok, nil
+		}
+		if ctxErr := ctx.Err(); ctxErr != nil {
+			return false, ctxErr
+		}
+
+		// A failed insert or commit usually means a concurrent writer claimed
+		// the slug first. Anything else is treated as transient and retried.
+		if exists, xerr := s.Exists(ctx, slug); xerr == nil && exists {
+			return false, nil
+		}
+		lastErr = err
+	}
+	return false, fmt.Errorf("sqlstore: reserve %q: %w", slug, lastErr)
+}
+
+// reserve runs one check-and-insert transaction.
+func (s *Store) reserve(ctx context.Context, slug string) (bool, error) {
+	tx, err := s.db.BeginTx(ctx, nil)
+	if err != nil {
+		return false, err
+	}
+	defer tx.Rollback()
+
+	var one int
+	err = tx.QueryRowContext(ctx, "SELECT 1 FROM "+s.table+" WHERE slug = "+s.placeholder(1), slug).Scan(&one)
+	switch {
+	case err == nil:
+		return false, nil
+	case !errors.Is(err, sql.ErrNoRows):
+		return false, err
+	}
+
+	if _, err := tx.ExecContext(ctx, "INSERT INTO "+s.table+" (slug) VALUES ("+s.placeholder(1)+")", slug); err != nil {
+		return false, err
+	}
+	if err := tx.Commit(); err != nil {
+		return false, err
+	}
+	return true, nil
+}
+
+// Exists reports whether slug is present in the table.
+func (s *Store) Exists(ctx context.Context, slug string) (bool, error) {
+	var one int
+	err := s.db.QueryRowContext(ctx, "SELECT 1 FROM "+s.table+" WHERE slug = "+s.placeholder(1), slug).Scan(&one)
+	switch {
+	case err == nil:
+		return true, nil
+	case errors.Is(err, sql.ErrNoRows):
+		return false, nil
+	default:
+		return false, err
+	}
+}
+
+// Release deletes slug from the table.
+func (s *Store) Release(ctx context.Context, slug string) error {
+	_, err := s.db.ExecContext(ctx, "DELETE FROM "+s.table+" WHERE slug = "+s.placeholder(1), slug)
+	return err
+}
@@ -0,0 +1,112 @@
+package sqlstore
+
+import (
+	"context"
+	"database/sql"
+	"path/filepath"
+	"sync"
+	"testing"
+
+	_ "github.com/mattn/go-sqlite3"
+	slugcraft "github.com/mnuddindev/slugcraft"
+)
+
+// openDB opens a handle to a SQLite file, as a separate app instance would.
+func openDB(t *testing.T, path string) *sql.DB {
+	t.Helper()
+	db, err := sql.Open("sqlite3", "file:"+path+"?_busy_timeout=5000&_txlock=immediate")
+	if err != nil {
+		t.Fatalf("sql.Open returned error: %v", err)
+	}
+	t.Cleanup(func() { db.Close() })
+	return db
+}
+
+// newStore creates a Store with its table on db.
+func newStore(t *testing.T, db *sql.DB) *Store {
+	t.Helper()
+	store, err := New(db)
+	if err != nil {
+		t.Fatalf("New returned error: %v", err)
+	}
+	if err := store.CreateTable(context.Background()); err != nil {
+		t.Fatalf("CreateTable returned error: %v", err)
+	}
+	return store
+}
+
+// TestStoreOperations tests reserve, exists and release.
+func TestStoreOperations(t *testing.T) {
+	store := newStore(t, openDB(t, filepath.Join(t.TempDir(), "slugs.db")))
+	ctx := context.Background()
+
+	ok, err := store.Reserve(ctx, "hello-world")
+	if err != nil || !ok {
+		t.Fatalf("Reserve = %v, %v, expected true, nil", ok, err)
+	}
+	ok, err = store.Reserve(ctx, "hello-world")
+	if err != nil || ok {
+		t.Errorf("second Reserve = %v, %v, expected false, nil", ok, err)
+	}
+	if exists, err := store.Exists(ctx, "hello-world"); err != nil || !exists {
+		t.Errorf("Exists = %v, %v, expected true, nil", exists, err)
+	}
+	if err := store.Release(ctx, "hello-world"); err != nil {
+		t.Errorf("Release returned error: %v", err)
+	}
+	if exists, err := store.Exists(ctx, "hello-world"); err != nil || exists {
+		t.Errorf("Exists after release = %v, %v, expected false, nil", exists, err)
+	}
+}
+
+// TestInvalidTable tests that unsafe table names are rejected.
+func TestInvalidTable(t *testing.T) {
+	db := openDB(t, filepath.Join(t.TempDir(), "slugs.db"))
+	if _, err := New(db, WithTable("slugs; DROP TABLE users")); err == nil {
+		t.Errorf("New with invalid table name did not return error")
+	}
+}
+
+// TestMakeAcrossInstances tests that two Configs sharing a database never
+// generate the same slug.
+func TestMakeAcrossInstances(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "slugs.db")
+	first := newStore(t, openDB(t, path))
+	second := newStore(t, openDB(t, path))
+
+	instances := []*slugcraft.Config{
+		slugcraft.New(slugcraft.WithUseCache(true), slugcraft.WithStore(first)),
+		slugcraft.New(slugcraft.WithUseCache(true), slugcraft.WithStore(second)),
+	}
+
+	const perInstance = 20
+	var wg sync.WaitGroup
+	slugs := make(chan string, 2*perInstance)
+	for _, s := range instances {
+		for i := 0; i < perInstance; i++ {
+			wg.Add(1)
+			go func(s *slugcraft.Config) {
+				defer wg.Done()
+				slug, err := s.Make(context.Background(), "Hello World")
+				if err != nil {
+					t.Errorf("Make returned error: %v", err)
+					return
+				}
+				slugs <- slug
+			}(s)
+		}
+	}
+	wg.Wait()
+	close(slugs)
+
+	seen := make(map[string]struct{})
+	for slug := range slugs {
+		if _, dup := seen[slug]; dup {
+			t.Errorf("slug %q generated more than once", slug)
+		}
+		seen[slug] = struct{}{}
+	}
+	if _, ok := seen["hello-world"]; !ok {
+		t.Errorf("base slug %q was never generated", "hello-world")
+	}
+}
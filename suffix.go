@@ -0,0 +1,150 @@
+package slugcraft
+
+import (
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/hex"
+	"strconv"
+	"time"
+	"unicode/utf8"
+)
+
+// Default lengths for the variable-length suffix styles.
+const (
+	defaultShortIDLength = 6
+	defaultHashLength    = 8
+	uuidLength           = 36
+)
+
+// Alphabets used by the random suffix styles.
+const (
+	base36Alphabet = "0123456789abcdefghijklmnopqrstuvwxyz"
+	base62Alphabet = "0123456789abcdefghijklmnopqrstuvwxyzABCDEFGHIJKLMNOPQRSTUVWXYZ"
+)
+
+// now is the clock used by the timestamp suffix styles.
+var now = time.Now
+
+// validSuffixStyle reports whether style is one of the built-in suffix styles.
+func validSuffixStyle(style string) bool {
+	switch style {
+	case "numeric", "version", "revision", "timestamp", "unix", "shortid", "shortid62", "hash", "uuid":
+		return true
+	}
+	return false
+}
+
+// suffix returns the text appended to base, separator included, on the given
+// collision attempt (starting at 1).
+func (cfg *Config) suffix(base string, attempt int) string {
+	sep := cfg.SuffixSeparator
+	switch cfg.SuffixStyle {
+	case "version":
+		return sep + "v" + itoa(attempt)
+	case "revision":
+		return sep + "rev" + itoa(attempt)
+	case "timestamp":
+		return sep + timeSuffix(now().UTC().Format("20060102150405"), sep, attempt)
+	case "unix":
+		return sep + timeSuffix(strconv.FormatInt(now().Unix(), 10), sep, attempt)
+	case "shortid":
+		return sep + randomID(base36Alphabet, cfg.idLength(defaultShortIDLength))
+	case "shortid62":
+		return sep + randomID(base62Alphabet, cfg.idLength(defaultShortIDLength))
+	case "hash":
+		return sep + hashSuffix(base, attempt, cfg.idLength(defaultHashLength))
+	case "uuid":
+		if n := cfg.idLength(uuidLength); n < uuidLength {
+			// Too long to fit: fall back to as many random hex digits as do
+			return sep + randomID(base36Alphabet[:16], n)
+		}
+		return sep + newUUID()
+	default:
+		return sep + itoa(attempt)
+	}
+}
+
+// suffixLength returns the configured suffix length or def when unset.
+func (cfg *Config) suffixLength(def int) int {
+	if cfg.SuffixLength > 0 {
+		return cfg.SuffixLength
+	}
+	return def
+}
+
+// idLength returns the length of a random or hashed suffix, cut down when
+// MaxLength would otherwise leave no room for the separator and one rune of
+// the slug. The suffix keeps at least one character.
+func (cfg *Config) idLength(def int) int {
+	n := cfg.suffixLength(def)
+	if cfg.MaxLength <= 0 {
+		return n
+	}
+	room := cfg.MaxLength - 1 - utf8.RuneCountInString(cfg.SuffixSeparator)
+	return max(1, min(n, room))
+}
+
+// timeSuffix numbers repeated collisions within the same clock tick.
+func timeSuffix(stamp, sep string, attempt int) string {
+	if attempt <= 1 {
+		return stamp
+	}
+	return stamp + sep + itoa(attempt-1)
+}
+
+// randomID returns n characters drawn uniformly from alphabet.
+func randomID(alphabet string, n int) string {
+	buf := make([]byte, n)
+	// Reject bytes above the largest multiple of len(alphabet) to avoid bias
+	limit := 256 - 256%len(alphabet)
+	var random [64]byte
+	for i := 0; i < n; {
+		rand.Read(random[:])
+		for _, r := range random {
+			if int(r) >= limit {
+				continue
+			}
+			buf[i] = alphabet[int(r)%len(alphabet)]
+			i++
+			if i == n {
+				break
+			}
+		}
+	}
+	return string(buf)
+}
+
+// hashSuffix returns the first n hex digits of a SHA-256 of base. Attempts
+// after the first mix in the attempt number so every retry differs.
+func hashSuffix(base string, attempt, n int) string {
+	input := base
+	if attempt > 1 {
+		input += "#" + itoa(attempt)
+	}
+	sum := sha256.Sum256([]byte(input))
+	digest := hex.EncodeToString(sum[:])
+	if n > len(digest) {
+		n = len(digest)
+	}
+	return digest[:n]
+}
+
+// newUUID returns a random (version 4) UUID.
+func newUUID() string {
+	var u [16]byte
+	rand.Read(u[:])
+	u[6] = (u[6] & 0x0f) | 0x40
+	u[8] = (u[8] & 0x3f) | 0x80
+
+	var buf [36]byte
+	hex.Encode(buf[0:8], u[0:4])
+	buf[8] = '-'
+	hex.Encode(buf[9:13], u[4:6])
+	buf[13] = '-'
+	hex.Encode(buf[14:18], u[6:8])
+	buf[18] = '-'
+	hex.Encode(buf[19:23], u[8:10])
+	buf[23] = '-'
+	hex.Encode(buf[24:], u[10:])
+	return string(buf[:])
+}
@@ -0,0 +1,264 @@
+package slugcraft
+
+import (
+	"strings"
+	"sync"
+	"unicode"
+	"unicode/utf8"
+)
+
+// Transliterator romanizes text in one language, writing the result to b.
+//
+// Implementations must be safe for concurrent use.
+type Transliterator interface {
+	Transliterate(input string, b *strings.Builder)
+}
+
+// TransliteratorFunc adapts an ordinary function to the Transliterator
+// interface.
+type TransliteratorFunc func(input string, b *strings.Builder)
+
+// Transliterate calls f(input, b).
+func (f TransliteratorFunc) Transliterate(input string, b *strings.Builder) {
+	f(input, b)
+}
+
+// configurable is implemented by transliterators that read settings from the
+// Config, such as the Japanese kanji reader.
+type configurable interface {
+	withConfig(cfg *Config) Transliterator
+}
+
+// transliterators maps each language to its schemes. The empty scheme name
+// holds the language default.
+var (
+	transliteratorsMu sync.RWMutex
+	transliterators   = make(map[string]map[string]Transliterator)
+)
+
+// RegisterTransliterator makes t the transliterator for lang, replacing any
+// earlier one. Register a named scheme with "lang:scheme" (e.g. "si:iso")
+// and select it with WithScheme; a bare lang sets the language default used
+// when no scheme is selected.
+func RegisterTransliterator(lang string, t Transliterator) {
+	lang, scheme, _ := strings.Cut(lang, ":")
+
+	transliteratorsMu.Lock()
+	defer transliteratorsMu.Unlock()
+	named, ok := transliterators[lang]
+	if !ok {
+		named = make(map[string]Transliterator)
+		transliterators[lang] = named
+	}
+	named[scheme] = t
+}
+
+func init() {
+	for name, table := range banglaSchemes {
+		RegisterTransliterator("bn:"+name, TransliteratorFunc(func(input string, b *strings.Builder) {
+			transliterateBangla(input, b, table)
+		}))
+	}
+	RegisterTransliterator("bn", TransliteratorFunc(func(input string, b *strings.Builder) {
+		TransliterateBangla(input, b)
+	}))
+
+	for name, table := range russianSchemes {
+		RegisterTransliterator("ru:"+name, cyrillicTransliterator(table))
+	}
+	RegisterTransliterator("ru", TransliteratorFunc(TransliterateRussian))
+	for lang, table := range cyrillicTables {
+		RegisterTransliterator(lang, cyrillicTransliterator(table))
+	}
+	for lang, table := range latinTables {
+		RegisterTransliterator(lang, TransliteratorFunc(func(input string, b *strings.Builder) {
+			transliterateLatin(input, b, table)
+		}))
+	}
+
+	for name, table := range arabicSchemes {
+		RegisterTransliterator("ar:"+name, TransliteratorFunc(func(input string, b *strings.Builder) {
+			transliterateArabic(input, b, table)
+		}))
+	}
+	RegisterTransliterator("ar", TransliteratorFunc(TransliterateArabic))
+
+	RegisterTransliterator("el", TransliteratorFunc(TransliterateGreek))
+	RegisterTransliterator("he", TransliteratorFunc(TransliterateHebrew))
+	RegisterTransliterator("yi", TransliteratorFunc(TransliterateYiddish))
+	for _, lang := range []string{"hi", "mr", "ne"} {
+		RegisterTransliterator(lang, TransliteratorFunc(TransliterateDevanagari))
+	}
+	RegisterTransliterator("zh", TransliteratorFunc(TransliterateChinese))
+	RegisterTransliterator("ja", japaneseTransliterator{})
+	RegisterTransliterator("ko", TransliteratorFunc(TransliterateKorean))
+	RegisterTransliterator("th", TransliteratorFunc(TransliterateThai))
+	RegisterTransliterator("lo", TransliteratorFunc(TransliterateLao))
+	RegisterTransliterator("km", TransliteratorFunc(TransliterateKhmer))
+	RegisterTransliterator("my", TransliteratorFunc(TransliterateBurmese))
+	RegisterTransliterator("auto", autoTransliterator{})
+}
+
+// cyrillicTransliterator returns a Transliterator for one Cyrillic table.
+func cyrillicTransliterator(table *cyrillicTable) Transliterator {
+	return TransliteratorFunc(func(input string, b *strings.Builder) {
+		transliterateCyrillic(input, b, table)
+	})
+}
+
+// japaneseTransliterator reads kanji with the Config's KanjiReader.
+type japaneseTransliterator struct {
+	reader KanjiReader
+}
+
+func (j japaneseTransliterator) Transliterate(input string, b *strings.Builder) {
+	transliterateJapanese(input, b, j.reader)
+}
+
+func (j japaneseTransliterator) withConfig(cfg *Config) Transliterator {
+	return japaneseTransliterator{reader: cfg.KanjiReader}
+}
+
+// autoScripts names the language whose rules romanize each script in auto
+// mode. Han is read as Chinese unless a KanjiReader is set and kana touch it.
+var autoScripts = []struct {
+	script *unicode.RangeTable
+	lang   string
+}{
+	{unicode.Latin, "latin"}, {unicode.Bengali, "bn"}, {unicode.Cyrillic, "ru"},
+	{unicode.Greek, "el"}, {unicode.Arabic, "ar"}, {unicode.Hebrew, "he"},
+	{unicode.Devanagari, "hi"}, {unicode.Han, "zh"}, {unicode.Hiragana, "ja"},
+	{unicode.Katakana, "ja"}, {unicode.Hangul, "ko"}, {unicode.Thai, "th"},
+	{unicode.Lao, "lo"}, {unicode.Khmer, "km"}, {unicode.Myanmar, "my"},
+}
+
+// autoTransliterator splits mixed-script input into runs of one script and
+// romanizes each with the transliterator the Config picks for its language.
+type autoTransliterator struct {
+	cfg *Config
+}
+
+func (a autoTransliterator) withConfig(cfg *Config) Transliterator {
+	return autoTransliterator{cfg: cfg}
+}
+
+func (a autoTransliterator) Transliterate(input string, b *strings.Builder) {
+	cfg := a.cfg
+	if cfg == nil {
+		cfg = &Config{}
+	}
+
+	flush := func(run, lang string) {
+		if run == "" {
+			return
+		}
+		// Runs in different scripts are different words
+		if s := b.String(); s != "" && s[len(s)-1] != ' ' && !unicode.IsSpace([]rune(run)[0]) {
+			b.WriteByte(' ')
+		}
+		t := cfg.transliterator(lang)
+		if j, ok := t.(japaneseTransliterator); ok && j.reader != nil {
+			// Kanji the reader can't read are read as Chinese
+			if zh := cfg.transliterator("zh"); zh != nil {
+				t = japaneseTransliterator{reader: hanFallback{j.reader, zh}}
+			}
+		}
+		switch {
+		case lang == "latin":
+			transliterateLatin(run, b, nil)
+		case t != nil:
+			t.Transliterate(run, b)
+		default:
+			b.WriteString(run)
+		}
+	}
+
+	// Spaces, digits, punctuation and marks stay in the run around them
+	start, lang, spaced := 0, "", false
+	for i, r := range input {
+		next := ""
+		for _, s := range autoScripts {
+			if unicode.Is(s.script, r) {
+				next = s.lang
+				break
+			}
+		}
+		if next == "" {
+			spaced = spaced || unicode.IsSpace(r)
+			continue
+		}
+		cjk := (next == "zh" || next == "ja") && (lang == "zh" || lang == "ja")
+		if cjk && !spaced && next != lang && cfg.KanjiReader != nil {
+			// Kana turn the Han next to them into Japanese
+			next, lang = "ja", "ja"
+		}
+		// Han and kana across a space start a new run, so kana on one
+		// side don't pull the Han on the other into Japanese
+		if next == lang && !(cjk && spaced) {
+			spaced = false
+			continue
+		}
+		spaced = false
+		if lang != "" {
+			flush(input[start:i], lang)
+			start = i
+		}
+		lang = next
+	}
+	flush(input[start:], lang)
+}
+
+// hanFallback reads kanji with reader and romanizes those it can't read with
+// the Chinese transliterator.
+type hanFallback struct {
+	reader KanjiReader
+	zh     Transliterator
+}
+
+func (h hanFallback) ReadKanji(text string) (string, int) {
+	if reading, size := h.reader.ReadKanji(text); size > 0 {
+		return reading, size
+	}
+	size := 0
+	for size < len(text) {
+		r, n := utf8.DecodeRuneInString(text[size:])
+		if !unicode.Is(unicode.Han, r) {
+			break
+		}
+		if _, known := h.reader.ReadKanji(text[size:]); size > 0 && known > 0 {
+			break
+		}
+		size += n
+	}
+	if size == 0 {
+		return "", 0
+	}
+	var b strings.Builder
+	h.zh.Transliterate(text[:size], &b)
+	return strings.TrimSpace(b.String()) + " ", size
+}
+
+// transliterator returns the transliterator cfg uses for lang: the scheme
+// chosen for lang, then the one chosen without a language, then the language
+// default. It returns nil when lang has none registered.
+func (cfg *Config) transliterator(lang string) Transliterator {
+	transliteratorsMu.RLock()
+	named := transliterators[lang]
+	t, ok := named[""]
+	for _, key := range []string{"", lang} {
+		if scheme, set := cfg.Schemes[key]; set {
+			if chosen, found := named[scheme]; found {
+				t, ok = chosen, true
+			}
+		}
+	}
+	transliteratorsMu.RUnlock()
+	if !ok {
+		return nil
+	}
+
+	if c, ok := t.(configurable); ok {
+		t = c.withConfig(cfg)
+	}
+	return t
+}
@@ -0,0 +1,116 @@
+package slugcraft
+
+import (
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
+
+// truncate cuts s to at most max runes. In "word" mode the cut is moved back
+// to the last separator, optionally after dropping stopwords, and only falls
+// back to a hard cut when the first word alone is longer than max.
+func (cfg *Config) truncate(s string, max int) string {
+	if max <= 0 {
+		return ""
+	}
+	if utf8.RuneCountInString(s) <= max {
+		return s
+	}
+	runes := []rune(s)
+	if cfg.Truncation != "word" {
+		return string(runes[:max])
+	}
+
+	if cfg.TruncateStopWords != nil {
+		runes = dropStopWords(runes, max, cfg.TruncateStopWords)
+		if len(runes) <= max {
+			return string(runes)
+		}
+	}
+
+	// The cut already falls on a word boundary
+	if isSeparator(runes[max]) {
+		return strings.TrimRightFunc(string(runes[:max]), isSeparator)
+	}
+	for i := max - 1; i > 0; i-- {
+		if isSeparator(runes[i]) {
+			trimmed := strings.TrimRightFunc(string(runes[:i]), isSeparator)
+			if trimmed != "" {
+				return trimmed
+			}
+			break
+		}
+	}
+	return string(runes[:max])
+}
+
+// dropStopWords removes stopwords from the end of s towards the start until s
+// fits in max runes or no stopwords are left. Each remaining word keeps the
+// separator that preceded it.
+func dropStopWords(s []rune, max int, stopWords map[string]struct{}) []rune {
+	type word struct {
+		sep, text []rune
+	}
+	var words []word
+	for i := 0; i < len(s); {
+		start := i
+		for i < len(s) && isSeparator(s[i]) {
+			i++
+		}
+		mid := i
+		for i < len(s) && !isSeparator(s[i]) {
+			i++
+		}
+		words = append(words, word{sep: s[start:mid], text: s[mid:i]})
+	}
+
+	length := len(s)
+	for i := len(words) - 1; i >= 0 && length > max; i-- {
+		if _, ok := stopWords[strings.ToLower(string(words[i].text))]; !ok {
+			continue
+		}
+		length -= len(words[i].sep) + len(words[i].text)
+		words[i].text = nil
+	}
+
+	out := make([]rune, 0, length)
+	for _, w := range words {
+		if w.text == nil {
+			continue
+		}
+		if len(out) > 0 {
+			out = append(out, w.sep...)
+		}
+		out = append(out, w.text...)
+	}
+	return out
+}
+
+// fit joins base and suffix, shortening base so the result never exceeds
+// MaxLength. A separator left dangling at the end of the shortened base is
+// dropped. At least one rune of base is kept; a suffix too long for that
+// yields ErrSuffixTooLong.
+func (cfg *Config) fit(base, suffix string) (string, error) {
+	if cfg.MaxLength <= 0 {
+		return base + suffix, nil
+	}
+	suffixLen := utf8.RuneCountInString(suffix)
+	if utf8.RuneCountInString(base)+suffixLen <= cfg.MaxLength {
+		return base + suffix, nil
+	}
+	room := cfg.MaxLength - suffixLen
+	if room <= 0 {
+		return "", ErrSuffixTooLong
+	}
+	trimmed := strings.TrimRightFunc(cfg.truncate(base, room), isSeparator)
+	if trimmed == "" {
+		return "", ErrSuffixTooLong
+	}
+	return trimmed + suffix, nil
+}
+
+// isSeparator reports whether r sits between words of a slug rather than
+// inside one.
+func isSeparator(r rune) bool {
+	return !unicode.IsLetter(r) && !unicode.IsDigit(r) && !unicode.IsMark(r)
+}
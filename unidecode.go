@@ -0,0 +1,87 @@
+package slugcraft
+
+import (
+	"bufio"
+	"bytes"
+	"compress/gzip"
+	_ "embed"
+	"strconv"
+	"strings"
+	"sync"
+)
+
+// The unidecode tables are derived from Sean M. Burke's Text::Unidecode, as
+// distributed by github.com/gosimple/unidecode (Apache License 2.0, see
+// data/unidecode.LICENSE). They map every Basic Multilingual Plane code point
+// with a known approximation to ASCII and are regenerated with:
+//
+//go:generate go run gen_unidecode.go -module github.com/gosimple/unidecode@v1.0.1 -src table.txt
+//go:embed data/unidecode.gz
+var unidecodeData []byte
+
+var (
+	unidecodeOnce sync.Once
+	// unidecodeBlocks holds one table per block of 256 code points; blocks
+	// without any mapping stay nil.
+	unidecodeBlocks [256]*[256]string
+)
+
+// loadUnidecode decodes the embedded tables.
+func loadUnidecode() {
+	zr, err := gzip.NewReader(bytes.NewReader(unidecodeData))
+	if err != nil {
+		panic("slugcraft: corrupt unidecode table: " + err.Error())
+	}
+	defer zr.Close()
+
+	scanner := bufio.NewScanner(zr)
+	for scanner.Scan() {
+		key, value, ok := strings.Cut(scanner.Text(), "\t")
+		if !ok {
+			continue
+		}
+		code, err := strconv.ParseUint(key, 16, 16)
+		if err != nil {
+			continue
+		}
+		ascii, err := strconv.Unquote(value)
+		if err != nil {
+			continue
+		}
+		block := unidecodeBlocks[code>>8]
+		if block == nil {
+			block = new([256]string)
+			unidecodeBlocks[code>>8] = block
+		}
+		block[code&0xff] = ascii
+	}
+	if err := scanner.Err(); err != nil {
+		panic("slugcraft: corrupt unidecode table: " + err.Error())
+	}
+}
+
+// unidecodeRune returns the ASCII approximation of r, or "" when none is known.
+func unidecodeRune(r rune) string {
+	if r < 0x80 {
+		return string(r)
+	}
+	if r > 0xffff {
+		return ""
+	}
+	unidecodeOnce.Do(loadUnidecode)
+	block := unidecodeBlocks[r>>8]
+	if block == nil {
+		return ""
+	}
+	return block[r&0xff]
+}
+
+// isASCII reports whether s contains only ASCII characters.
+func isASCII(s string) bool {
+	for i := 0; i < len(s); i++ {
+		if s[i] >= 0x80 {
+			return false
+		}
+	}
+	return true
+}